@@ -12,6 +12,10 @@ import (
 
 const (
 	storageFileName = "ttrack_api.db"
+
+	// dsnOptions let transactions acquire the write lock on begin, so checks done inside them can't be raced. Foreign
+	// keys are activated for every connection of the pool.
+	dsnOptions = "?_txlock=immediate&_foreign_keys=1"
 )
 
 // Database initialises the database and returns the connection.
@@ -66,7 +70,7 @@ func createSchema(db *sqlx.DB, scriptPath, version string, withBar bool) error {
 }
 
 func open(fileName string) (*sqlx.DB, error) {
-	db, err := sqlx.Open("sqlite3", fileName)
+	db, err := sqlx.Open("sqlite3", fileName+dsnOptions)
 
 	return db, err // nolint: wrapcheck
 }
@@ -49,6 +49,17 @@ func TestDatabase(t *testing.T) {
 		"sqlite_sequence",
 		"timelogs",
 		"publicholidays",
+		"projects",
+		"tags",
+		"timelog_tags",
+		"schedules",
+		"vacations",
+		"calendars",
+		"taxsettings",
+		"offices",
+		"users",
+		"tokens",
+		"teams",
 	}
 
 	// 1. setup
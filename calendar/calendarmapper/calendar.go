@@ -0,0 +1,170 @@
+package calendarmapper
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmodel"
+	"github.com/rebel-l/ttrack_api/calendar/calendarstore"
+)
+
+var (
+	// ErrLoadFromDB occurs if something went wrong on loading.
+	ErrLoadFromDB = errors.New("failed to load calendar from database")
+
+	// ErrNoData occurs if given model is nil.
+	ErrNoData = errors.New("calendar is nil")
+
+	// ErrSaveToDB occurs if something went wrong on saving.
+	ErrSaveToDB = errors.New("failed to save calendar to database")
+
+	// ErrDeleteFromDB occurs if something went wrong on deleting.
+	ErrDeleteFromDB = errors.New("failed to delete calendar from database")
+
+	// ErrNotFound occurs if record doesn't exist in database.
+	ErrNotFound = errors.New("calendar was not found")
+
+	// ErrNameExists occurs if there is already another calendar with the same name.
+	ErrNameExists = errors.New("there is already a calendar with this name")
+
+	// ErrDeleteDefault occurs if the default calendar should be deleted.
+	ErrDeleteDefault = errors.New("the default calendar can't be deleted")
+)
+
+// Mapper provides methods to load and persist calendar models.
+type Mapper struct {
+	db *sqlx.DB
+}
+
+// New returns a new mapper.
+func New(db *sqlx.DB) *Mapper {
+	return &Mapper{db: db}
+}
+
+// Load returns a calendar model loaded from database by ID.
+func (m *Mapper) Load(ctx context.Context, id uuid.UUID) (*calendarmodel.Calendar, error) {
+	s := &calendarstore.Calendar{ID: id} // nolint: exhaustivestruct
+
+	if err := s.Read(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// LoadByName returns a calendar model loaded from database by its name.
+func (m *Mapper) LoadByName(ctx context.Context, name string) (*calendarmodel.Calendar, error) {
+	s := &calendarstore.Calendars{}
+
+	if err := s.Load(ctx, m.db, "name = ?", name); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	if len(*s) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return StoreToModel((*s)[0]), nil
+}
+
+// Resolve returns the calendar selected by the given ID or name. If the selector is empty, the default calendar is
+// returned.
+func (m *Mapper) Resolve(ctx context.Context, selector string) (*calendarmodel.Calendar, error) {
+	if selector == "" {
+		return m.Load(ctx, calendarmodel.DefaultID)
+	}
+
+	if id, err := uuid.Parse(selector); err == nil {
+		return m.Load(ctx, id)
+	}
+
+	return m.LoadByName(ctx, selector)
+}
+
+// Save persists (create or update) the model and returns the changed data (id, createdAt or modifiedAt). It fails
+// with ErrNameExists if there is another calendar with the same name.
+func (m *Mapper) Save(ctx context.Context, model *calendarmodel.Calendar) (*calendarmodel.Calendar, error) {
+	if model == nil {
+		return nil, ErrNoData
+	}
+
+	s := modelToStore(model)
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	existing := &calendarstore.Calendars{}
+	if err := existing.Load(ctx, tx, "name = ? AND id != ?", s.Name, s.ID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	if len(*existing) > 0 {
+		return nil, ErrNameExists
+	}
+
+	if uuidutils.IsEmpty(model.ID) {
+		if err := s.Create(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	} else {
+		if err := s.Update(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// Delete removes a model from database by ID. The public holidays of the calendar are deleted too. The default
+// calendar can't be deleted.
+func (m *Mapper) Delete(ctx context.Context, id uuid.UUID) error {
+	if id == calendarmodel.DefaultID {
+		return ErrDeleteDefault
+	}
+
+	s := &calendarstore.Calendar{ID: id} // nolint: exhaustivestruct
+	if err := s.Delete(ctx, m.db); err != nil {
+		return fmt.Errorf("%w: %v", ErrDeleteFromDB, err)
+	}
+
+	return nil
+}
+
+// StoreToModel returns a model based on the given store object. It maps all properties from store to model.
+func StoreToModel(s *calendarstore.Calendar) *calendarmodel.Calendar {
+	if s == nil {
+		return &calendarmodel.Calendar{} // nolint: exhaustivestruct
+	}
+
+	return &calendarmodel.Calendar{
+		ID:         s.ID,
+		Name:       s.Name,
+		CreatedAt:  s.CreatedAt,
+		ModifiedAt: s.ModifiedAt,
+	}
+}
+
+// modelToStore returns a store based on the given model object. It maps all properties from model to store.
+func modelToStore(m *calendarmodel.Calendar) *calendarstore.Calendar {
+	return &calendarstore.Calendar{
+		ID:         m.ID,
+		Name:       m.Name,
+		CreatedAt:  m.CreatedAt,
+		ModifiedAt: m.ModifiedAt,
+	}
+}
@@ -0,0 +1,220 @@
+package calendarmapper_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rebel-l/go-utils/osutils"
+	"github.com/rebel-l/go-utils/testingutils"
+	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmapper"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmodel"
+	"github.com/rebel-l/ttrack_api/config"
+)
+
+func setup(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+
+	// 0. init path
+	storagePath := filepath.Join(".", "..", "..", "storage", "test_calendar", name)
+	scriptPath := filepath.Join(".", "..", "..", "scripts", "sql", "sqlite")
+	conf := &config.Database{
+		StoragePath:       &storagePath,
+		SchemaScriptsPath: &scriptPath,
+	}
+
+	// 1. clean up
+	if osutils.FileOrPathExists(conf.GetStoragePath()) {
+		if err := os.RemoveAll(conf.GetStoragePath()); err != nil {
+			t.Fatalf("failed to cleanup test files: %v", err)
+		}
+	}
+
+	// 2. init database
+	db, err := bootstrap.Database(conf, "0.0.0", false)
+	if err != nil {
+		t.Fatalf("No error expected: %v", err)
+	}
+
+	return db
+}
+
+func TestMapper_SaveLoad(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperSaveLoad")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := calendarmapper.New(db)
+	ctx := context.Background()
+
+	// 2. test
+	if _, err := mapper.Save(ctx, nil); !errors.Is(err, calendarmapper.ErrNoData) {
+		t.Errorf("expected error '%v' but got '%v'", calendarmapper.ErrNoData, err)
+	}
+
+	if _, err := mapper.Load(ctx, testingutils.UUIDParse(t, "5f0d3c1e-7b1a-4c8e-9d2f-6a5b4c3d2e1f")); !errors.Is(err, calendarmapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", calendarmapper.ErrNotFound, err)
+	}
+
+	saved, err := mapper.Save(ctx, &calendarmodel.Calendar{Name: "Bayern"})
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	if _, err := mapper.Save(ctx, &calendarmodel.Calendar{Name: "Bayern"}); !errors.Is(err, calendarmapper.ErrNameExists) {
+		t.Errorf("expected error '%v' but got '%v'", calendarmapper.ErrNameExists, err)
+	}
+
+	saved.Name = "Bavaria"
+
+	updated, err := mapper.Save(ctx, saved)
+	if err != nil {
+		t.Fatalf("expected no error on update but got '%v'", err)
+	}
+
+	loaded, err := mapper.Load(ctx, saved.ID)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if loaded.ID != updated.ID || loaded.Name != "Bavaria" {
+		t.Errorf("expected calendar '%v' but got '%v'", updated, loaded)
+	}
+
+	all, err := mapper.LoadAll(ctx)
+	if err != nil {
+		t.Fatalf("expected no error on load all but got '%v'", err)
+	}
+
+	if len(all) != 2 {
+		t.Errorf("expected 2 calendars including the default one but got %d", len(all))
+	}
+}
+
+func TestMapper_Resolve(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperResolve")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := calendarmapper.New(db)
+	ctx := context.Background()
+
+	calendar, err := mapper.Save(ctx, &calendarmodel.Calendar{Name: "Bayern"})
+	if err != nil {
+		t.Fatalf("failed to prepare calendar: %v", err)
+	}
+
+	// 2. test
+	testCases := []struct {
+		name        string
+		selector    string
+		expected    uuid.UUID
+		expectedErr error
+	}{
+		{
+			name:     "empty selects default",
+			expected: calendarmodel.DefaultID,
+		},
+		{
+			name:     "by id",
+			selector: calendar.ID.String(),
+			expected: calendar.ID,
+		},
+		{
+			name:     "by name",
+			selector: "Bayern",
+			expected: calendar.ID,
+		},
+		{
+			name:        "unknown name",
+			selector:    "Hessen",
+			expectedErr: calendarmapper.ErrNotFound,
+		},
+		{
+			name:        "unknown id",
+			selector:    "5f0d3c1e-7b1a-4c8e-9d2f-6a5b4c3d2e1f",
+			expectedErr: calendarmapper.ErrNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := mapper.Resolve(ctx, testCase.selector)
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+
+			if actual != nil && actual.ID != testCase.expected {
+				t.Errorf("expected calendar %s but got %s", testCase.expected, actual.ID)
+			}
+		})
+	}
+}
+
+func TestMapper_Delete(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperDelete")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := calendarmapper.New(db)
+	ctx := context.Background()
+
+	calendar, err := mapper.Save(ctx, &calendarmodel.Calendar{Name: "Bayern"})
+	if err != nil {
+		t.Fatalf("failed to prepare calendar: %v", err)
+	}
+
+	// 2. test
+	if err := mapper.Delete(ctx, calendarmodel.DefaultID); !errors.Is(err, calendarmapper.ErrDeleteDefault) {
+		t.Errorf("expected error '%v' but got '%v'", calendarmapper.ErrDeleteDefault, err)
+	}
+
+	if err := mapper.Delete(ctx, calendar.ID); err != nil {
+		t.Fatalf("expected no error on delete but got '%v'", err)
+	}
+
+	if _, err := mapper.Load(ctx, calendar.ID); !errors.Is(err, calendarmapper.ErrNotFound) {
+		t.Errorf("expected that calendar was deleted but got error '%v'", err)
+	}
+}
@@ -0,0 +1,25 @@
+package calendarmapper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rebel-l/ttrack_api/calendar/calendarmodel"
+	"github.com/rebel-l/ttrack_api/calendar/calendarstore"
+)
+
+// LoadAll returns all calendars ordered by name.
+func (m *Mapper) LoadAll(ctx context.Context) (calendarmodel.Calendars, error) {
+	s := &calendarstore.Calendars{}
+
+	if err := s.Load(ctx, m.db, ""); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	models := calendarmodel.Calendars{}
+	for _, v := range *s {
+		models = append(models, StoreToModel(v))
+	}
+
+	return models, nil
+}
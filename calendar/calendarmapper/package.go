@@ -0,0 +1,2 @@
+// Package calendarmapper provides functionality to read and persist calendars.
+package calendarmapper
@@ -0,0 +1,69 @@
+package calendarmodel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxLengthName defines the maximum number of characters of the calendar name.
+	MaxLengthName = 100
+
+	// DefaultName is the name of the calendar public holidays belong to if no other calendar is selected.
+	DefaultName = "Default"
+)
+
+// DefaultID is the ID of the calendar public holidays belong to if no other calendar is selected.
+var DefaultID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+var (
+	// ErrDecodeJSON occurs if a string is not in JSON format.
+	ErrDecodeJSON = errors.New("failed to decode JSON")
+
+	// ErrValidationNameMandatory occurs during validation if the name wasn't set.
+	ErrValidationNameMandatory = errors.New("name should not be empty")
+
+	// ErrValidationTooLong occurs during validation if a value exceeds its maximum length.
+	ErrValidationTooLong = errors.New("value is too long")
+)
+
+// Calendar represents a named list of public holidays, e.g. for a state or country.
+type Calendar struct {
+	ID         uuid.UUID `json:"ID"`
+	Name       string    `json:"Name"`
+	CreatedAt  time.Time `json:"CreatedAt"`
+	ModifiedAt time.Time `json:"ModifiedAt"`
+}
+
+// DecodeJSON converts JSON data to struct.
+func (c *Calendar) DecodeJSON(reader io.Reader) error {
+	if c == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(reader)
+	if err := decoder.Decode(c); err != nil {
+		return fmt.Errorf("%w: %v", ErrDecodeJSON, err)
+	}
+
+	return nil
+}
+
+// Validate is validating the attributes of the struct to valid values. If the validation fails it returns the reason
+// why it failed in the error message.
+func (c *Calendar) Validate() error {
+	if c.Name == "" {
+		return ErrValidationNameMandatory
+	}
+
+	if len([]rune(c.Name)) > MaxLengthName {
+		return fmt.Errorf("%w: name has more than %d characters", ErrValidationTooLong, MaxLengthName)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package calendarmodel_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/go-utils/testingutils"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmodel"
+)
+
+func TestCalendar_DecodeJSON(t *testing.T) {
+	t.Parallel()
+
+	createdAt, _ := time.Parse(time.RFC3339Nano, "2019-12-31T03:36:57.9167778+01:00")
+	modifiedAt, _ := time.Parse(time.RFC3339Nano, "2020-01-01T15:44:57.9168378+01:00")
+
+	testCases := []struct {
+		name        string
+		actual      *calendarmodel.Calendar
+		json        io.Reader
+		expected    *calendarmodel.Calendar
+		expectedErr error
+	}{
+		{
+			name: "model is nil",
+		},
+		{
+			name:        "no JSON format",
+			actual:      &calendarmodel.Calendar{},
+			json:        bytes.NewReader([]byte("no JSON")),
+			expected:    &calendarmodel.Calendar{},
+			expectedErr: calendarmodel.ErrDecodeJSON,
+		},
+		{
+			name:   "success",
+			actual: &calendarmodel.Calendar{},
+			json: bytes.NewReader([]byte(`
+                {
+    "ID": "0d6d9a58-6bb4-4a4c-a1b1-7f1e8b6c2e54",
+    "Name": "Bayern",
+    "CreatedAt": "2019-12-31T03:36:57.9167778+01:00",
+    "ModifiedAt": "2020-01-01T15:44:57.9168378+01:00"
+}
+            `)),
+			expected: &calendarmodel.Calendar{
+				ID:         testingutils.UUIDParse(t, "0d6d9a58-6bb4-4a4c-a1b1-7f1e8b6c2e54"),
+				Name:       "Bayern",
+				CreatedAt:  createdAt,
+				ModifiedAt: modifiedAt,
+			},
+		},
+		{
+			name:     "empty json",
+			actual:   &calendarmodel.Calendar{},
+			json:     bytes.NewReader([]byte("{}")),
+			expected: &calendarmodel.Calendar{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.actual.DecodeJSON(testCase.json)
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+
+				return
+			}
+
+			assertCalendar(t, testCase.expected, testCase.actual)
+		})
+	}
+}
+
+func TestCalendar_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		actual      *calendarmodel.Calendar
+		expectedErr error
+	}{
+		{
+			name:        "name is empty",
+			actual:      &calendarmodel.Calendar{},
+			expectedErr: calendarmodel.ErrValidationNameMandatory,
+		},
+		{
+			name:        "name is too long",
+			actual:      &calendarmodel.Calendar{Name: strings.Repeat("n", calendarmodel.MaxLengthName+1)},
+			expectedErr: calendarmodel.ErrValidationTooLong,
+		},
+		{
+			name:   "valid",
+			actual: &calendarmodel.Calendar{Name: "Baden-Württemberg"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.actual.Validate()
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func assertCalendar(t *testing.T, expected, actual *calendarmodel.Calendar) {
+	t.Helper()
+
+	if expected == nil && actual == nil {
+		return
+	}
+
+	if expected != nil && actual == nil || expected == nil && actual != nil {
+		t.Errorf("expected '%v' but got '%v'", expected, actual)
+
+		return
+	}
+
+	if expected.ID != actual.ID {
+		t.Errorf("expected ID %s but got %s", expected.ID, actual.ID)
+	}
+
+	if expected.Name != actual.Name {
+		t.Errorf("expected Name %s but got %s", expected.Name, actual.Name)
+	}
+
+	if !expected.CreatedAt.Equal(actual.CreatedAt) {
+		t.Errorf("expected created at '%s' but got '%s'", expected.CreatedAt.String(), actual.CreatedAt.String())
+	}
+
+	if !expected.ModifiedAt.Equal(actual.ModifiedAt) {
+		t.Errorf("expected modified at '%s' but got '%s'", expected.ModifiedAt.String(), actual.ModifiedAt.String())
+	}
+}
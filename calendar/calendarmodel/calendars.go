@@ -0,0 +1,3 @@
+package calendarmodel
+
+type Calendars []*Calendar
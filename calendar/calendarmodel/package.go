@@ -0,0 +1,2 @@
+// Package calendarmodel provides functionality and business logic to manage calendars.
+package calendarmodel
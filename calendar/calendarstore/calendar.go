@@ -0,0 +1,139 @@
+package calendarstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+)
+
+const (
+	qSelect = `
+		SELECT id, name, created_at, modified_at
+        FROM calendars
+	`
+)
+
+var (
+	// ErrIDMissing will be thrown if an ID is expected but not set.
+	ErrIDMissing = errors.New("id is mandatory for this operation")
+
+	// ErrCreatingID will be thrown if creating an ID failed.
+	ErrCreatingID = errors.New("id creation failed")
+
+	// ErrIDIsSet will be thrown if no ID is expected but already set.
+	ErrIDIsSet = errors.New("id should be not set for this operation, use update instead")
+
+	// ErrDataMissing will be thrown if mandatory data is not set.
+	ErrDataMissing = errors.New("no data or mandatory data missing")
+)
+
+// Calendar represents the calendar in the database.
+type Calendar struct {
+	ID         uuid.UUID `db:"id"`
+	Name       string    `db:"name"`
+	CreatedAt  time.Time `db:"created_at"`
+	ModifiedAt time.Time `db:"modified_at"`
+}
+
+// Create creates current object in the database.
+func (c *Calendar) Create(ctx context.Context, db sqlx.ExtContext) error {
+	if !c.IsValid() {
+		return ErrDataMissing
+	}
+
+	if !uuidutils.IsEmpty(c.ID) {
+		return ErrIDIsSet
+	}
+
+	var err error
+
+	c.ID, err = uuid.NewRandom()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCreatingID, err)
+	}
+
+	q := db.Rebind(`
+		INSERT INTO calendars (id, name) 
+		VALUES (?, ?);
+	`)
+
+	_, err = db.ExecContext(ctx, q, c.ID, c.Name)
+	if err != nil {
+		return fmt.Errorf("failed to create: %w", err)
+	}
+
+	return c.Read(ctx, db)
+}
+
+// Read sets the calendar from database by given ID.
+func (c *Calendar) Read(ctx context.Context, db sqlx.ExtContext) error {
+	if c == nil || uuidutils.IsEmpty(c.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE id = ?;
+    `)
+	if err := sqlx.GetContext(ctx, db, c, q, c.ID); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// Update changes the current object on the database by ID.
+func (c *Calendar) Update(ctx context.Context, db sqlx.ExtContext) error {
+	if !c.IsValid() {
+		return ErrDataMissing
+	}
+
+	if uuidutils.IsEmpty(c.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+		UPDATE calendars 
+		SET name = ? 
+		WHERE id = ?;
+	`)
+
+	_, err := db.ExecContext(ctx, q, c.Name, c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update: %w", err)
+	}
+
+	return c.Read(ctx, db)
+}
+
+// Delete removes the current object from database by its ID.
+func (c *Calendar) Delete(ctx context.Context, db sqlx.ExtContext) error {
+	if c == nil || uuidutils.IsEmpty(c.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+        DELETE FROM calendars
+        WHERE id = ?
+    `)
+
+	if _, err := db.ExecContext(ctx, q, c.ID); err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	return nil
+}
+
+// IsValid returns true if all mandatory fields are set.
+func (c *Calendar) IsValid() bool {
+	if c == nil || c.Name == "" {
+		return false
+	}
+
+	return true
+}
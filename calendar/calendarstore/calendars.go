@@ -0,0 +1,27 @@
+package calendarstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Calendars []*Calendar
+
+func (c *Calendars) Load(ctx context.Context, db sqlx.ExtContext, where string, args ...any) error {
+	q := qSelect
+	if where != "" {
+		q += " WHERE " + where
+	}
+	q += " ORDER BY name "
+
+	if err := sqlx.SelectContext(ctx, db, c, db.Rebind(q), args...); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	return nil
+}
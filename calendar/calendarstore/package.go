@@ -0,0 +1,2 @@
+// Package calendarstore contains the CRUD operations for the calendars on the database.
+package calendarstore
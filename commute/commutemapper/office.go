@@ -0,0 +1,144 @@
+package commutemapper
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+	"github.com/rebel-l/ttrack_api/commute/commutemodel"
+	"github.com/rebel-l/ttrack_api/commute/commutestore"
+)
+
+var (
+	// ErrLoadFromDB occurs if something went wrong on loading.
+	ErrLoadFromDB = errors.New("failed to load office from database")
+
+	// ErrNoData occurs if given model is nil.
+	ErrNoData = errors.New("office is nil")
+
+	// ErrSaveToDB occurs if something went wrong on saving.
+	ErrSaveToDB = errors.New("failed to save office to database")
+
+	// ErrDeleteFromDB occurs if something went wrong on deleting.
+	ErrDeleteFromDB = errors.New("failed to delete office from database")
+
+	// ErrNotFound occurs if record doesn't exist in database.
+	ErrNotFound = errors.New("office was not found")
+)
+
+// Mapper provides methods to load and persist office models.
+type Mapper struct {
+	db *sqlx.DB
+}
+
+// New returns a new mapper.
+func New(db *sqlx.DB) *Mapper {
+	return &Mapper{db: db}
+}
+
+// Load returns an office model loaded from database by ID.
+func (m *Mapper) Load(ctx context.Context, id uuid.UUID) (*commutemodel.Office, error) {
+	s := &commutestore.Office{ID: id} // nolint: exhaustivestruct
+
+	if err := s.Read(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// LoadDefault returns the office marked as default.
+func (m *Mapper) LoadDefault(ctx context.Context) (*commutemodel.Office, error) {
+	s := &commutestore.Office{} // nolint: exhaustivestruct
+
+	if err := s.ReadDefault(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// Save persists (create or update) the model and returns the changed data (id, createdAt or modifiedAt). There is
+// only one default office, so saving a default office removes the mark from all others.
+func (m *Mapper) Save(ctx context.Context, model *commutemodel.Office) (*commutemodel.Office, error) {
+	if model == nil {
+		return nil, ErrNoData
+	}
+
+	s := modelToStore(model)
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	if uuidutils.IsEmpty(model.ID) {
+		if err := s.Create(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	} else {
+		if err := s.Update(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	}
+
+	if s.Default {
+		if err := s.ResetOtherDefaults(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// Delete removes a model from database by ID. Timelogs assigned to the office lose their assignment.
+func (m *Mapper) Delete(ctx context.Context, id uuid.UUID) error {
+	s := &commutestore.Office{ID: id} // nolint: exhaustivestruct
+	if err := s.Delete(ctx, m.db); err != nil {
+		return fmt.Errorf("%w: %v", ErrDeleteFromDB, err)
+	}
+
+	return nil
+}
+
+// StoreToModel returns a model based on the given store object. It maps all properties from store to model.
+func StoreToModel(s *commutestore.Office) *commutemodel.Office {
+	if s == nil {
+		return &commutemodel.Office{} // nolint: exhaustivestruct
+	}
+
+	return &commutemodel.Office{
+		ID:         s.ID,
+		Name:       s.Name,
+		Distance:   s.Distance,
+		Default:    s.Default,
+		CreatedAt:  s.CreatedAt,
+		ModifiedAt: s.ModifiedAt,
+	}
+}
+
+// modelToStore returns a store based on the given model object. It maps all properties from model to store.
+func modelToStore(m *commutemodel.Office) *commutestore.Office {
+	return &commutestore.Office{
+		ID:         m.ID,
+		Name:       m.Name,
+		Distance:   m.Distance,
+		Default:    m.Default,
+		CreatedAt:  m.CreatedAt,
+		ModifiedAt: m.ModifiedAt,
+	}
+}
@@ -0,0 +1,202 @@
+package commutemapper_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rebel-l/go-utils/osutils"
+	"github.com/rebel-l/go-utils/testingutils"
+	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/commute/commutemapper"
+	"github.com/rebel-l/ttrack_api/commute/commutemodel"
+	"github.com/rebel-l/ttrack_api/config"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func setup(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+
+	// 0. init path
+	storagePath := filepath.Join(".", "..", "..", "storage", "test_commute", name)
+	scriptPath := filepath.Join(".", "..", "..", "scripts", "sql", "sqlite")
+	conf := &config.Database{
+		StoragePath:       &storagePath,
+		SchemaScriptsPath: &scriptPath,
+	}
+
+	// 1. clean up
+	if osutils.FileOrPathExists(conf.GetStoragePath()) {
+		if err := os.RemoveAll(conf.GetStoragePath()); err != nil {
+			t.Fatalf("failed to cleanup test files: %v", err)
+		}
+	}
+
+	// 2. init database
+	db, err := bootstrap.Database(conf, "0.0.0", false)
+	if err != nil {
+		t.Fatalf("No error expected: %v", err)
+	}
+
+	return db
+}
+
+func TestMapper_SaveLoad(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperSaveLoad")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := commutemapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+
+	// 2. test
+	if _, err := mapper.Save(ctx, nil); !errors.Is(err, commutemapper.ErrNoData) {
+		t.Errorf("expected error '%v' but got '%v'", commutemapper.ErrNoData, err)
+	}
+
+	if _, err := mapper.Load(ctx, testingutils.UUIDParse(t, "5f0d3c1e-7b1a-4c8e-9d2f-6a5b4c3d2e1f")); !errors.Is(err, commutemapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", commutemapper.ErrNotFound, err)
+	}
+
+	if _, err := mapper.LoadDefault(ctx); !errors.Is(err, commutemapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", commutemapper.ErrNotFound, err)
+	}
+
+	first, err := mapper.Save(ctx, &commutemodel.Office{Name: "Berlin", Distance: 12.5, Default: true})
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	second, err := mapper.Save(ctx, &commutemodel.Office{Name: "Potsdam", Distance: 35})
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	loaded, err := mapper.LoadDefault(ctx)
+	if err != nil {
+		t.Fatalf("expected no error on load default but got '%v'", err)
+	}
+
+	if loaded.ID != first.ID || loaded.Distance != 12.5 {
+		t.Errorf("expected default office '%v' but got '%v'", first, loaded)
+	}
+
+	// only one office can be the default
+	second.Default = true
+
+	if _, err := mapper.Save(ctx, second); err != nil {
+		t.Fatalf("expected no error on update but got '%v'", err)
+	}
+
+	loaded, err = mapper.LoadDefault(ctx)
+	if err != nil {
+		t.Fatalf("expected no error on load default but got '%v'", err)
+	}
+
+	if loaded.ID != second.ID {
+		t.Errorf("expected default office '%v' but got '%v'", second, loaded)
+	}
+
+	loaded, err = mapper.Load(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if loaded.Default {
+		t.Errorf("expected office '%v' not to be the default anymore", loaded)
+	}
+
+	all, err := mapper.LoadAll(ctx)
+	if err != nil {
+		t.Fatalf("expected no error on load all but got '%v'", err)
+	}
+
+	if len(all) != 2 {
+		t.Errorf("expected 2 offices but got %d", len(all))
+	}
+}
+
+func TestMapper_Delete(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperDelete")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := commutemapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+
+	office, err := mapper.Save(ctx, &commutemodel.Office{Name: "Berlin", Distance: 12.5})
+	if err != nil {
+		t.Fatalf("failed to prepare office: %v", err)
+	}
+
+	stop := time.Date(2024, 6, 3, 17, 0, 0, 0, time.UTC)
+	tMapper := timelogmapper.New(db)
+
+	timelog, err := tMapper.Save(ctx, &timelogmodel.Timelog{
+		Start:    time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC),
+		Stop:     &stop,
+		Reason:   timelogmodel.ReasonWork,
+		Location: timelogmodel.LocationOffice,
+		OfficeID: &office.ID,
+	})
+	if err != nil {
+		t.Fatalf("failed to prepare timelog: %v", err)
+	}
+
+	// 2. test
+	if err := mapper.Delete(ctx, office.ID); err != nil {
+		t.Fatalf("expected no error on delete but got '%v'", err)
+	}
+
+	if _, err := mapper.Load(ctx, office.ID); !errors.Is(err, commutemapper.ErrNotFound) {
+		t.Errorf("expected that office was deleted but got error '%v'", err)
+	}
+
+	timelog, err = tMapper.Load(ctx, timelog.ID)
+	if err != nil {
+		t.Fatalf("expected timelog to be kept but got error '%v'", err)
+	}
+
+	if timelog.OfficeID != nil {
+		t.Errorf("expected office of timelog to be removed but got %s", timelog.OfficeID)
+	}
+
+	_, err = tMapper.Save(ctx, &timelogmodel.Timelog{
+		Start:    time.Date(2024, 6, 4, 8, 0, 0, 0, time.UTC),
+		Reason:   timelogmodel.ReasonWork,
+		Location: timelogmodel.LocationOffice,
+		OfficeID: &office.ID,
+	})
+	if !errors.Is(err, timelogmapper.ErrOfficeNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", timelogmapper.ErrOfficeNotFound, err)
+	}
+}
@@ -0,0 +1,25 @@
+package commutemapper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rebel-l/ttrack_api/commute/commutemodel"
+	"github.com/rebel-l/ttrack_api/commute/commutestore"
+)
+
+// LoadAll returns all offices ordered by name.
+func (m *Mapper) LoadAll(ctx context.Context) (commutemodel.Offices, error) {
+	s := &commutestore.Offices{}
+
+	if err := s.Load(ctx, m.db, ""); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	models := commutemodel.Offices{}
+	for _, v := range *s {
+		models = append(models, StoreToModel(v))
+	}
+
+	return models, nil
+}
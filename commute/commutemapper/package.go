@@ -0,0 +1,2 @@
+// Package commutemapper provides functionality to read and persist the offices of the commute profile.
+package commutemapper
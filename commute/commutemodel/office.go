@@ -0,0 +1,76 @@
+package commutemodel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxLengthName defines the maximum number of characters of the office name.
+	MaxLengthName = 100
+
+	// MaxDistance defines the maximum distance in kilometres between home and office.
+	MaxDistance = 1000
+)
+
+var (
+	// ErrDecodeJSON occurs if a string is not in JSON format.
+	ErrDecodeJSON = errors.New("failed to decode JSON")
+
+	// ErrValidationNameMandatory occurs during validation if the name wasn't set.
+	ErrValidationNameMandatory = errors.New("name should not be empty")
+
+	// ErrValidationTooLong occurs during validation if a value exceeds its maximum length.
+	ErrValidationTooLong = errors.New("value is too long")
+
+	// ErrValidationDistance occurs during validation if the distance is out of range.
+	ErrValidationDistance = errors.New("distance is out of range")
+)
+
+// Office represents an office location of the commute profile. Distance is the one-way distance from home to the
+// office in kilometres. Office days without an office assigned are accounted to the Default office.
+type Office struct {
+	ID         uuid.UUID `json:"ID"`
+	Name       string    `json:"Name"`
+	Distance   float64   `json:"Distance"`
+	Default    bool      `json:"Default"`
+	CreatedAt  time.Time `json:"CreatedAt"`
+	ModifiedAt time.Time `json:"ModifiedAt"`
+}
+
+// DecodeJSON converts JSON data to struct.
+func (o *Office) DecodeJSON(reader io.Reader) error {
+	if o == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(reader)
+	if err := decoder.Decode(o); err != nil {
+		return fmt.Errorf("%w: %v", ErrDecodeJSON, err)
+	}
+
+	return nil
+}
+
+// Validate is validating the attributes of the struct to valid values. If the validation fails it returns the reason
+// why it failed in the error message.
+func (o *Office) Validate() error {
+	if o.Name == "" {
+		return ErrValidationNameMandatory
+	}
+
+	if len([]rune(o.Name)) > MaxLengthName {
+		return fmt.Errorf("%w: name has more than %d characters", ErrValidationTooLong, MaxLengthName)
+	}
+
+	if o.Distance <= 0 || o.Distance > MaxDistance {
+		return fmt.Errorf("%w: distance has %v", ErrValidationDistance, o.Distance)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package commutemodel_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rebel-l/ttrack_api/commute/commutemodel"
+)
+
+func TestOffice_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		office      *commutemodel.Office
+		expectedErr error
+	}{
+		{
+			name:        "name missing",
+			office:      &commutemodel.Office{Distance: 12},
+			expectedErr: commutemodel.ErrValidationNameMandatory,
+		},
+		{
+			name:        "name too long",
+			office:      &commutemodel.Office{Name: strings.Repeat("a", commutemodel.MaxLengthName+1), Distance: 12},
+			expectedErr: commutemodel.ErrValidationTooLong,
+		},
+		{
+			name:        "distance missing",
+			office:      &commutemodel.Office{Name: "Headquarter"},
+			expectedErr: commutemodel.ErrValidationDistance,
+		},
+		{
+			name:        "distance too far",
+			office:      &commutemodel.Office{Name: "Headquarter", Distance: commutemodel.MaxDistance + 1},
+			expectedErr: commutemodel.ErrValidationDistance,
+		},
+		{
+			name:   "valid",
+			office: &commutemodel.Office{Name: "Headquarter", Distance: 12.5, Default: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := testCase.office.Validate(); !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+		})
+	}
+}
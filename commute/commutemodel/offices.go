@@ -0,0 +1,3 @@
+package commutemodel
+
+type Offices []*Office
@@ -0,0 +1,2 @@
+// Package commutemodel provides functionality and business logic to manage the offices of the commute profile.
+package commutemodel
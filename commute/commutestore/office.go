@@ -0,0 +1,177 @@
+package commutestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+)
+
+const (
+	qSelect = `
+		SELECT id, name, distance, is_default, created_at, modified_at
+        FROM offices
+	`
+)
+
+var (
+	// ErrIDMissing will be thrown if an ID is expected but not set.
+	ErrIDMissing = errors.New("id is mandatory for this operation")
+
+	// ErrCreatingID will be thrown if creating an ID failed.
+	ErrCreatingID = errors.New("id creation failed")
+
+	// ErrIDIsSet will be thrown if no ID is expected but already set.
+	ErrIDIsSet = errors.New("id should be not set for this operation, use update instead")
+
+	// ErrDataMissing will be thrown if mandatory data is not set.
+	ErrDataMissing = errors.New("no data or mandatory data missing")
+)
+
+// Office represents the office in the database.
+type Office struct {
+	ID         uuid.UUID `db:"id"`
+	Name       string    `db:"name"`
+	Distance   float64   `db:"distance"`
+	Default    bool      `db:"is_default"`
+	CreatedAt  time.Time `db:"created_at"`
+	ModifiedAt time.Time `db:"modified_at"`
+}
+
+// Create creates current object in the database.
+func (o *Office) Create(ctx context.Context, db sqlx.ExtContext) error {
+	if !o.IsValid() {
+		return ErrDataMissing
+	}
+
+	if !uuidutils.IsEmpty(o.ID) {
+		return ErrIDIsSet
+	}
+
+	var err error
+
+	o.ID, err = uuid.NewRandom()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCreatingID, err)
+	}
+
+	q := db.Rebind(`
+		INSERT INTO offices (id, name, distance, is_default)
+		VALUES (?, ?, ?, ?);
+	`)
+
+	_, err = db.ExecContext(ctx, q, o.ID, o.Name, o.Distance, o.Default)
+	if err != nil {
+		return fmt.Errorf("failed to create: %w", err)
+	}
+
+	return o.Read(ctx, db)
+}
+
+// Read sets the office from database by given ID.
+func (o *Office) Read(ctx context.Context, db sqlx.ExtContext) error {
+	if o == nil || uuidutils.IsEmpty(o.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE id = ?;
+    `)
+	if err := sqlx.GetContext(ctx, db, o, q, o.ID); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// ReadDefault sets the office from database which is marked as default.
+func (o *Office) ReadDefault(ctx context.Context, db sqlx.ExtContext) error {
+	if o == nil {
+		return ErrDataMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE is_default = 1;
+    `)
+	if err := sqlx.GetContext(ctx, db, o, q); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// Update changes the current object on the database by ID.
+func (o *Office) Update(ctx context.Context, db sqlx.ExtContext) error {
+	if !o.IsValid() {
+		return ErrDataMissing
+	}
+
+	if uuidutils.IsEmpty(o.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+		UPDATE offices
+		SET name = ?, distance = ?, is_default = ?
+		WHERE id = ?;
+	`)
+
+	_, err := db.ExecContext(ctx, q, o.Name, o.Distance, o.Default, o.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update: %w", err)
+	}
+
+	return o.Read(ctx, db)
+}
+
+// ResetOtherDefaults removes the default mark from all other offices, so the current one is the only default.
+func (o *Office) ResetOtherDefaults(ctx context.Context, db sqlx.ExtContext) error {
+	if o == nil || uuidutils.IsEmpty(o.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+		UPDATE offices
+		SET is_default = 0
+		WHERE is_default = 1 AND id <> ?;
+	`)
+
+	if _, err := db.ExecContext(ctx, q, o.ID); err != nil {
+		return fmt.Errorf("failed to reset defaults: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the current object from database by its ID.
+func (o *Office) Delete(ctx context.Context, db sqlx.ExtContext) error {
+	if o == nil || uuidutils.IsEmpty(o.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+        DELETE FROM offices
+        WHERE id = ?
+    `)
+
+	if _, err := db.ExecContext(ctx, q, o.ID); err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	return nil
+}
+
+// IsValid returns true if all mandatory fields are set.
+func (o *Office) IsValid() bool {
+	if o == nil || o.Name == "" {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,27 @@
+package commutestore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Offices []*Office
+
+func (o *Offices) Load(ctx context.Context, db sqlx.ExtContext, where string, args ...any) error {
+	q := qSelect
+	if where != "" {
+		q += " WHERE " + where
+	}
+	q += " ORDER BY name "
+
+	if err := sqlx.SelectContext(ctx, db, o, db.Rebind(q), args...); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	return nil
+}
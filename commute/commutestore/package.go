@@ -0,0 +1,2 @@
+// Package commutestore contains the CRUD operations for the offices of the commute profile on the database.
+package commutestore
@@ -0,0 +1,52 @@
+package config
+
+// JWT provides the configuration to validate JSON Web Tokens issued by an identity provider. Tokens signed with HS256
+// are validated by the shared secret, tokens signed with RS256 by the public keys of the local JWKS file. Issuer and
+// audience are only checked if they are set.
+type JWT struct {
+	Secret   *string `json:"secret"`
+	JWKSPath *string `json:"jwks_path"`
+	Issuer   *string `json:"issuer"`
+	Audience *string `json:"audience"`
+}
+
+// GetSecret returns the shared secret for tokens signed with HS256.
+func (j *JWT) GetSecret() string {
+	if j == nil || j.Secret == nil {
+		return ""
+	}
+
+	return *j.Secret
+}
+
+// GetJWKSPath returns the path to the JWKS file containing the public keys for tokens signed with RS256.
+func (j *JWT) GetJWKSPath() string {
+	if j == nil || j.JWKSPath == nil {
+		return ""
+	}
+
+	return *j.JWKSPath
+}
+
+// GetIssuer returns the expected issuer of the tokens.
+func (j *JWT) GetIssuer() string {
+	if j == nil || j.Issuer == nil {
+		return ""
+	}
+
+	return *j.Issuer
+}
+
+// GetAudience returns the expected audience of the tokens.
+func (j *JWT) GetAudience() string {
+	if j == nil || j.Audience == nil {
+		return ""
+	}
+
+	return *j.Audience
+}
+
+// IsEnabled returns true if a secret or a JWKS file is configured to validate tokens.
+func (j *JWT) IsEnabled() bool {
+	return j.GetSecret() != "" || j.GetJWKSPath() != ""
+}
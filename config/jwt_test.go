@@ -0,0 +1,55 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/rebel-l/ttrack_api/config"
+)
+
+func TestJWT_IsEnabled(t *testing.T) {
+	t.Parallel()
+
+	secret := "secret"
+	path := "./jwks.json"
+	empty := ""
+
+	testCases := []struct {
+		name     string
+		jwt      *config.JWT
+		expected bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "no values",
+			jwt:  &config.JWT{},
+		},
+		{
+			name: "empty values",
+			jwt:  &config.JWT{Secret: &empty, JWKSPath: &empty},
+		},
+		{
+			name:     "secret",
+			jwt:      &config.JWT{Secret: &secret},
+			expected: true,
+		},
+		{
+			name:     "jwks",
+			jwt:      &config.JWT{JWKSPath: &path},
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.jwt.IsEnabled(); got != testCase.expected {
+				t.Errorf("expected enabled to be %t but got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package calendars
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmapper"
+	"github.com/sirupsen/logrus"
+)
+
+func (c *calendar) loadAll(writer http.ResponseWriter, request *http.Request) {
+	log := c.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request could not be handled",
+			Internal:   "writer is nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	mapper := calendarmapper.New(c.db)
+
+	model, err := mapper.LoadAll(request.Context())
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "CAL-ALL",
+			External:   "failed to load calendars",
+			Internal:   "failed to load calendars",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
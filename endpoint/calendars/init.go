@@ -0,0 +1,48 @@
+package calendars
+
+import (
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+// Init initializes the endpoints to manage calendars.
+// nolint: wrapcheck,nolintlint
+func Init(svc *smis.Service, db *sqlx.DB) error {
+	endpoint := &calendar{db: db, svc: svc}
+
+	route, err := svc.RegisterEndpoint("/calendars", http.MethodGet, endpoint.loadAll)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeCalendarsRead)
+
+	route, err = svc.RegisterEndpoint("/calendars", http.MethodPut, endpoint.upsert)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeCalendarsWrite, auth.ScopeHolidaysAdmin)
+	auth.RequireRoles(route, usermodel.RoleAdmin)
+
+	route, err = svc.RegisterEndpoint("/calendars/{id}", http.MethodGet, endpoint.load)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeCalendarsRead)
+
+	route, err = svc.RegisterEndpoint("/calendars/{id}", http.MethodDelete, endpoint.delete)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeCalendarsWrite, auth.ScopeHolidaysAdmin)
+	auth.RequireRoles(route, usermodel.RoleAdmin)
+
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package calendars provide the endpoints to manage calendars.
+package calendars
@@ -0,0 +1,49 @@
+package calendars
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmapper"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmodel"
+)
+
+// QueryParam is the query parameter selecting a calendar by its ID or name.
+const QueryParam = "calendar"
+
+// FromQuery returns the calendar selected by the query parameter calendar, either by its ID or name. Without the
+// parameter the default calendar is returned. If the calendar can't be loaded, an error response is written and
+// false is returned.
+func FromQuery(
+	writer http.ResponseWriter,
+	request *http.Request,
+	response smis.Response,
+	db *sqlx.DB,
+) (*calendarmodel.Calendar, bool) {
+	calendar, err := calendarmapper.New(db).Resolve(request.Context(), request.URL.Query().Get(QueryParam))
+	if errors.Is(err, calendarmapper.ErrNotFound) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusNotFound,
+			Code:       "CAL-LOAD",
+			External:   err.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return nil, false
+	} else if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "CAL-LOAD",
+			External:   "failed to load calendar",
+			Internal:   "failed to load calendar",
+			Details:    err,
+		})
+
+		return nil, false
+	}
+
+	return calendar, true
+}
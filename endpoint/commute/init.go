@@ -0,0 +1,45 @@
+package commute
+
+import (
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
+)
+
+// Init initializes the endpoints to manage the offices to commute to.
+// nolint: wrapcheck,nolintlint
+func Init(svc *smis.Service, db *sqlx.DB) error {
+	endpoint := &office{db: db, svc: svc}
+
+	route, err := svc.RegisterEndpoint("/commute/offices", http.MethodGet, endpoint.loadAll)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeCommuteRead)
+
+	route, err = svc.RegisterEndpoint("/commute/offices", http.MethodPut, endpoint.upsert)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeCommuteWrite)
+
+	route, err = svc.RegisterEndpoint("/commute/offices/{id}", http.MethodGet, endpoint.load)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeCommuteRead)
+
+	route, err = svc.RegisterEndpoint("/commute/offices/{id}", http.MethodDelete, endpoint.delete)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeCommuteWrite)
+
+	return nil
+}
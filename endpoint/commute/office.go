@@ -0,0 +1,173 @@
+package commute
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/commute/commutemapper"
+	"github.com/rebel-l/ttrack_api/commute/commutemodel"
+	"github.com/sirupsen/logrus"
+)
+
+type office struct {
+	db  *sqlx.DB
+	svc *smis.Service
+}
+
+func (o *office) upsert(writer http.ResponseWriter, request *http.Request) {
+	log := o.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil || request == nil || request.Body == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request had no data",
+			Internal:   "writer or request nil",
+			Details:    nil,
+		})
+
+		return
+	}
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	model := &commutemodel.Office{} // nolint: exhaustivestruct
+	if err := model.DecodeJSON(request.Body); err != nil {
+		response.WriteJSONError(writer, smis.ErrResponseJSONConversion.WithDetails(err))
+
+		return
+	}
+
+	if err := model.Validate(); err != nil {
+		response.WriteJSONError(writer, smis.Error{ // nolint: exhaustivestruct
+			StatusCode: http.StatusBadRequest,
+			Code:       "VALIDATION",
+			External:   err.Error(),
+		})
+
+		return
+	}
+
+	mapper := commutemapper.New(o.db)
+
+	model, err := mapper.Save(request.Context(), model)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "COM-SAVE",
+			External:   "failed to save office",
+			Internal:   "failed to save office",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
+
+func (o *office) load(writer http.ResponseWriter, request *http.Request) {
+	log := o.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	id, ok := parseID(writer, request, response)
+	if !ok {
+		return
+	}
+
+	mapper := commutemapper.New(o.db)
+
+	model, err := mapper.Load(request.Context(), id)
+	if errors.Is(err, commutemapper.ErrNotFound) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusNotFound,
+			Code:       "COM-LOAD",
+			External:   err.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	} else if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "COM-LOAD",
+			External:   "failed to load office",
+			Internal:   "failed to load office",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
+
+func (o *office) delete(writer http.ResponseWriter, request *http.Request) {
+	log := o.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	id, ok := parseID(writer, request, response)
+	if !ok {
+		return
+	}
+
+	mapper := commutemapper.New(o.db)
+	if err := mapper.Delete(request.Context(), id); err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "COM-DELETE",
+			External:   "failed to delete office",
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// parseID returns the id from the path of the request. If it is missing or invalid, an error response is written
+// and false is returned.
+func parseID(writer http.ResponseWriter, request *http.Request, response smis.Response) (uuid.UUID, bool) {
+	vars := mux.Vars(request)
+	id, ok := vars["id"]
+	if !ok { //nolint: wsl
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "no id defined",
+			Internal:   "no id defined",
+			Details:    nil,
+		})
+
+		return uuid.Nil, false
+	}
+
+	idParsed, err := uuid.Parse(id)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "no id defined",
+			Internal:   "id not a uuid",
+			Details:    err,
+		})
+
+		return uuid.Nil, false
+	}
+
+	return idParsed, true
+}
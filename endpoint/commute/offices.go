@@ -0,0 +1,52 @@
+package commute
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/commute/commutemapper"
+	"github.com/sirupsen/logrus"
+)
+
+func (o *office) loadAll(writer http.ResponseWriter, request *http.Request) {
+	log := o.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request could not be handled",
+			Internal:   "writer is nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	mapper := commutemapper.New(o.db)
+
+	model, err := mapper.LoadAll(request.Context())
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "COM-ALL",
+			External:   "failed to load offices",
+			Internal:   "failed to load offices",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
@@ -0,0 +1,2 @@
+// Package commute provide the endpoints to manage the offices of the commute profile.
+package commute
@@ -0,0 +1,45 @@
+package projects
+
+import (
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
+)
+
+// Init initializes the endpoints to manage projects.
+// nolint: wrapcheck,nolintlint
+func Init(svc *smis.Service, db *sqlx.DB) error {
+	endpoint := &project{db: db, svc: svc}
+
+	route, err := svc.RegisterEndpoint("/projects", http.MethodGet, endpoint.loadAll)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeProjectsRead)
+
+	route, err = svc.RegisterEndpoint("/projects", http.MethodPut, endpoint.upsert)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeProjectsWrite)
+
+	route, err = svc.RegisterEndpoint("/projects/{id}", http.MethodGet, endpoint.load)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeProjectsRead)
+
+	route, err = svc.RegisterEndpoint("/projects/{id}", http.MethodDelete, endpoint.delete)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeProjectsWrite)
+
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package projects provide the endpoints to manage projects.
+package projects
@@ -0,0 +1,173 @@
+package projects
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/project/projectmapper"
+	"github.com/rebel-l/ttrack_api/project/projectmodel"
+	"github.com/sirupsen/logrus"
+)
+
+type project struct {
+	db  *sqlx.DB
+	svc *smis.Service
+}
+
+func (p *project) upsert(writer http.ResponseWriter, request *http.Request) {
+	log := p.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil || request == nil || request.Body == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request had no data",
+			Internal:   "writer or request nil",
+			Details:    nil,
+		})
+
+		return
+	}
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	model := &projectmodel.Project{} // nolint: exhaustivestruct
+	if err := model.DecodeJSON(request.Body); err != nil {
+		response.WriteJSONError(writer, smis.ErrResponseJSONConversion.WithDetails(err))
+
+		return
+	}
+
+	if err := model.Validate(); err != nil {
+		response.WriteJSONError(writer, smis.Error{ // nolint: exhaustivestruct
+			StatusCode: http.StatusBadRequest,
+			Code:       "VALIDATION",
+			External:   err.Error(),
+		})
+
+		return
+	}
+
+	mapper := projectmapper.New(p.db)
+
+	model, err := mapper.Save(request.Context(), model)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "PRJ-SAVE",
+			External:   "failed to save project",
+			Internal:   "failed to save project",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
+
+func (p *project) load(writer http.ResponseWriter, request *http.Request) {
+	log := p.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	id, ok := parseID(writer, request, response)
+	if !ok {
+		return
+	}
+
+	mapper := projectmapper.New(p.db)
+
+	model, err := mapper.Load(request.Context(), id)
+	if errors.Is(err, projectmapper.ErrNotFound) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusNotFound,
+			Code:       "PRJ-LOAD",
+			External:   err.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	} else if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "PRJ-LOAD",
+			External:   "failed to load project",
+			Internal:   "failed to load project",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
+
+func (p *project) delete(writer http.ResponseWriter, request *http.Request) {
+	log := p.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	id, ok := parseID(writer, request, response)
+	if !ok {
+		return
+	}
+
+	mapper := projectmapper.New(p.db)
+	if err := mapper.Delete(request.Context(), id); err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "PRJ-DELETE",
+			External:   "failed to delete project",
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// parseID returns the id from the path of the request. If it is missing or invalid, an error response is written
+// and false is returned.
+func parseID(writer http.ResponseWriter, request *http.Request, response smis.Response) (uuid.UUID, bool) {
+	vars := mux.Vars(request)
+	id, ok := vars["id"]
+	if !ok { //nolint: wsl
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "no id defined",
+			Internal:   "no id defined",
+			Details:    nil,
+		})
+
+		return uuid.Nil, false
+	}
+
+	idParsed, err := uuid.Parse(id)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "no id defined",
+			Internal:   "id not a uuid",
+			Details:    err,
+		})
+
+		return uuid.Nil, false
+	}
+
+	return idParsed, true
+}
@@ -0,0 +1,52 @@
+package projects
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/project/projectmapper"
+	"github.com/sirupsen/logrus"
+)
+
+func (p *project) loadAll(writer http.ResponseWriter, request *http.Request) {
+	log := p.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request could not be handled",
+			Internal:   "writer is nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	mapper := projectmapper.New(p.db)
+
+	model, err := mapper.LoadAll(request.Context())
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "PRJ-ALL",
+			External:   "failed to load projects",
+			Internal:   "failed to load projects",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
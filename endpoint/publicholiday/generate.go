@@ -0,0 +1,111 @@
+package publicholiday
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/endpoint/calendars"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymapper"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidayrules"
+	"github.com/sirupsen/logrus"
+)
+
+func (p *publicHoliday) generate(writer http.ResponseWriter, request *http.Request) {
+	log := p.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "PHL-GEN",
+			External:   "request could not be handled",
+			Internal:   "writer is nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	year, err := strconv.Atoi(mux.Vars(request)["year"])
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "PHL-GEN",
+			External:   "cannot parse year",
+			Internal:   "cannot parse year",
+			Details:    err,
+		})
+
+		return
+	}
+
+	models, err := publicholidayrules.Generate(year, request.URL.Query().Get("region"))
+	if errors.Is(err, publicholidayrules.ErrUnknownRegion) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "PHL-GEN",
+			External: fmt.Sprintf(
+				"%s, region must be one of: %s", err.Error(), strings.Join(publicholidayrules.Regions(), ", "),
+			),
+			Internal: err.Error(),
+			Details:  nil,
+		})
+
+		return
+	} else if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "PHL-GEN",
+			External:   "failed to generate public holidays",
+			Internal:   "failed to generate public holidays",
+			Details:    err,
+		})
+
+		return
+	}
+
+	calendar, ok := calendars.FromQuery(writer, request, response, p.db)
+	if !ok {
+		return
+	}
+
+	for _, v := range models {
+		if v != nil {
+			v.CalendarID = calendar.ID
+		}
+	}
+
+	mapper := publicholidaymapper.New(p.db)
+
+	models, err = mapper.SaveMissing(request.Context(), models)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "PHL-GEN",
+			External:   "failed to save public holidays",
+			Internal:   "failed to save public holidays",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, publicholidaymodel.PublicHolidaysByYear{
+		publicholidaymodel.Year(year): models,
+	})
+}
@@ -0,0 +1,112 @@
+package publicholiday
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/endpoint/calendars"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymapper"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+	"github.com/sirupsen/logrus"
+)
+
+func (p *publicHoliday) importICS(writer http.ResponseWriter, request *http.Request) {
+	log := p.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil || request == nil || request.Body == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "PHL-IMPORT",
+			External:   "request had no data",
+			Internal:   "writer or request nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	update := false
+
+	if v := request.URL.Query().Get("update"); v != "" {
+		var err error
+
+		update, err = strconv.ParseBool(v)
+		if err != nil {
+			response.WriteJSONError(writer, smis.Error{
+				StatusCode: http.StatusBadRequest,
+				Code:       "PHL-IMPORT",
+				External:   "update must be a boolean",
+				Internal:   "cannot parse update",
+				Details:    err,
+			})
+
+			return
+		}
+	}
+
+	models, err := publicholidaymodel.ReadICS(request.Body)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "PHL-IMPORT",
+			External:   err.Error(),
+			Internal:   "failed to read iCalendar",
+			Details:    err,
+		})
+
+		return
+	}
+
+	calendar, ok := calendars.FromQuery(writer, request, response, p.db)
+	if !ok {
+		return
+	}
+
+	for _, v := range models {
+		if v == nil {
+			continue
+		}
+
+		v.CalendarID = calendar.ID
+
+		if err := v.Validate(); err != nil {
+			response.WriteJSONError(writer, smis.Error{ // nolint: exhaustivestruct
+				StatusCode: http.StatusBadRequest,
+				Code:       "VALIDATION",
+				External:   fmt.Sprintf("%s: %v", v.Day.Format(time.DateOnly), err),
+			})
+
+			return
+		}
+	}
+
+	mapper := publicholidaymapper.New(p.db)
+
+	models, err = mapper.SaveByDay(request.Context(), models, update)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "PHL-IMPORT",
+			External:   "failed to import public holidays",
+			Internal:   "failed to import public holidays",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, models.ByYear())
+}
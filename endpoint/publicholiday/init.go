@@ -5,6 +5,8 @@ import (
 
 	"github.com/jmoiron/sqlx"
 	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
 )
 
 // Init initializes the endpoints regarding publicholiday.
@@ -12,11 +14,51 @@ import (
 func Init(svc *smis.Service, db *sqlx.DB) error {
 	endpoint := &publicHoliday{db: db, svc: svc}
 
-	if _, err := svc.RegisterEndpoint("/publicholidays", http.MethodGet, endpoint.loadAll); err != nil {
+	route, err := svc.RegisterEndpoint("/publicholidays", http.MethodGet, endpoint.loadAll)
+	if err != nil {
 		return err
 	}
 
-	_, err := svc.RegisterEndpoint("/publicholidays", http.MethodPut, endpoint.save)
+	auth.RequireScopes(route, auth.ScopeHolidaysRead)
 
-	return err
+	route, err = svc.RegisterEndpoint("/publicholidays", http.MethodPut, endpoint.save)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeHolidaysAdmin)
+	auth.RequireRoles(route, usermodel.RoleAdmin)
+
+	route, err = svc.RegisterEndpoint("/publicholidays/import", http.MethodPost, endpoint.importICS)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeHolidaysAdmin)
+	auth.RequireRoles(route, usermodel.RoleAdmin)
+
+	route, err = svc.RegisterEndpoint("/publicholidays/generate/{year}", http.MethodPost, endpoint.generate)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeHolidaysAdmin)
+	auth.RequireRoles(route, usermodel.RoleAdmin)
+
+	route, err = svc.RegisterEndpoint("/publicholidays/{id}", http.MethodGet, endpoint.load)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeHolidaysRead)
+
+	route, err = svc.RegisterEndpoint("/publicholidays/{id}", http.MethodDelete, endpoint.delete)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeHolidaysAdmin)
+	auth.RequireRoles(route, usermodel.RoleAdmin)
+
+	return nil
 }
@@ -2,11 +2,16 @@ package publicholiday
 
 import (
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
 	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/endpoint/calendars"
 	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymapper"
 	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
 	"github.com/sirupsen/logrus"
@@ -17,6 +22,13 @@ type publicHoliday struct {
 	svc *smis.Service
 }
 
+// bulkErrorResponse is the payload returned if public holidays of a bulk save were rejected.
+type bulkErrorResponse struct {
+	Code  string                          `json:"code"`
+	Error string                          `json:"error"`
+	Items []publicholidaymapper.ItemError `json:"items"`
+}
+
 func (p *publicHoliday) loadAll(writer http.ResponseWriter, request *http.Request) {
 	log := p.svc.NewLogForRequestID(request.Context())
 	response := smis.Response{Log: log}
@@ -41,9 +53,14 @@ func (p *publicHoliday) loadAll(writer http.ResponseWriter, request *http.Reques
 		}
 	}(log, request.Body)
 
+	calendar, ok := calendars.FromQuery(writer, request, response, p.db)
+	if !ok {
+		return
+	}
+
 	mapper := publicholidaymapper.New(p.db)
 
-	model, err := mapper.LoadAll(request.Context())
+	model, err := mapper.LoadAll(request.Context(), calendar.ID)
 	if err != nil {
 		response.WriteJSONError(writer, smis.Error{
 			StatusCode: http.StatusInternalServerError,
@@ -90,22 +107,153 @@ func (p *publicHoliday) save(writer http.ResponseWriter, request *http.Request)
 		return
 	}
 
-	for i, v := range models {
-		var err error
+	calendar, ok := calendars.FromQuery(writer, request, response, p.db)
+	if !ok {
+		return
+	}
+
+	for _, v := range models {
+		if v != nil && uuidutils.IsEmpty(v.CalendarID) {
+			v.CalendarID = calendar.ID
+		}
+	}
+
+	mapper := publicholidaymapper.New(p.db)
+
+	saved, err := mapper.SaveAll(request.Context(), models)
+
+	var bulkErr *publicholidaymapper.BulkError
+	if errors.As(err, &bulkErr) {
+		log.Warn(bulkErr.Error())
 
-		mapper := publicholidaymapper.New(p.db)
-		models[i], err = mapper.Save(request.Context(), v)
-		if err != nil {
-			response.WriteJSONError(writer, smis.ErrResponseJSONConversion.WithDetails(err)) // TODO: maybe custom error?
-			return
+		statusCode := http.StatusBadRequest
+		if bulkErr.Conflict() {
+			statusCode = http.StatusConflict
 		}
+
+		response.WriteJSON(writer, statusCode, bulkErrorResponse{
+			Code:  "PHL-SAVE",
+			Error: publicholidaymapper.ErrBulkSave.Error(),
+			Items: bulkErr.Items,
+		})
+
+		return
+	} else if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "PHL-SAVE",
+			External:   "failed to save public holidays",
+			Internal:   "failed to save public holidays",
+			Details:    err,
+		})
+
+		return
 	}
 
-	model := make(publicholidaymodel.PublicHolidaysByYear)
-	if len(models) > 0 {
-		year := publicholidaymodel.Year(models[0].Day.Year())
-		model[year] = models
+	response.WriteJSON(writer, http.StatusOK, saved.ByYear())
+}
+
+func (p *publicHoliday) load(writer http.ResponseWriter, request *http.Request) {
+	log := p.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	id, ok := parseID(writer, request, response)
+	if !ok {
+		return
+	}
+
+	mapper := publicholidaymapper.New(p.db)
+
+	model, err := mapper.Load(request.Context(), id)
+	if errors.Is(err, publicholidaymapper.ErrNotFound) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusNotFound,
+			Code:       "PHL-LOAD",
+			External:   err.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	} else if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "PHL-LOAD",
+			External:   "failed to load public holiday",
+			Internal:   "failed to load public holiday",
+			Details:    err,
+		})
+
+		return
 	}
 
 	response.WriteJSON(writer, http.StatusOK, model)
 }
+
+func (p *publicHoliday) delete(writer http.ResponseWriter, request *http.Request) {
+	log := p.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	id, ok := parseID(writer, request, response)
+	if !ok {
+		return
+	}
+
+	mapper := publicholidaymapper.New(p.db)
+	if err := mapper.Delete(request.Context(), id); errors.Is(err, publicholidaymapper.ErrNotFound) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusNotFound,
+			Code:       "PHL-DELETE",
+			External:   err.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	} else if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "PHL-DELETE",
+			External:   "failed to delete public holiday",
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// parseID returns the id from the path of the request. If it is missing or invalid, an error response is written
+// and false is returned.
+func parseID(writer http.ResponseWriter, request *http.Request, response smis.Response) (uuid.UUID, bool) {
+	vars := mux.Vars(request)
+	id, ok := vars["id"]
+	if !ok { //nolint: wsl
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "no id defined",
+			Internal:   "no id defined",
+			Details:    nil,
+		})
+
+		return uuid.Nil, false
+	}
+
+	idParsed, err := uuid.Parse(id)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "no id defined",
+			Internal:   "id not a uuid",
+			Details:    err,
+		})
+
+		return uuid.Nil, false
+	}
+
+	return idParsed, true
+}
@@ -0,0 +1,115 @@
+package reports
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/endpoint/calendars"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymapper"
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/schedule/schedulemapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/sirupsen/logrus"
+)
+
+func (r *reports) balance(writer http.ResponseWriter, request *http.Request) {
+	log := r.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request could not be handled",
+			Internal:   "writer is nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	yearNum, ok := parseYear(writer, request, response)
+	if !ok {
+		return
+	}
+
+	schedulesMapper := schedulemapper.New(r.db)
+	schedules, err := schedulesMapper.LoadAll(request.Context())
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "RPT-SCH",
+			External:   "failed to calculate balance",
+			Internal:   "failed to load schedules",
+			Details:    err,
+		})
+
+		return
+	}
+
+	calendar, ok := calendars.FromQuery(writer, request, response, r.db)
+	if !ok {
+		return
+	}
+
+	publicHolidaysMapper := publicholidaymapper.New(r.db)
+	publicHolidays, err := publicHolidaysMapper.LoadByYear(request.Context(), calendar.ID, yearNum)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "RPT-PHL",
+			External:   "failed to calculate balance",
+			Internal:   "failed to load public holidays",
+			Details:    err,
+		})
+
+		return
+	}
+
+	timelogsMapper := timelogmapper.New(r.db)
+	timelogs, err := timelogsMapper.LoadByYear(request.Context(), yearNum)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "RPT-TL",
+			External:   "failed to calculate balance",
+			Internal:   "failed to load timelogs",
+			Details:    err,
+		})
+
+		return
+	}
+
+	model := reportmodel.NewBalance(yearNum)
+
+	// days in the future have no actual hours yet, so the balance ends today
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+	if today.Before(model.LastDay) {
+		model.LastDay = today
+	}
+
+	if err := model.Calculate(schedules, publicHolidays, timelogs); err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "",
+			External:   "failed to calculate balance",
+			Internal:   "failed to calculate balance",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
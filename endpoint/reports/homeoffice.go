@@ -0,0 +1,84 @@
+package reports
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/tax/taxmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/sirupsen/logrus"
+)
+
+func (r *reports) homeOffice(writer http.ResponseWriter, request *http.Request) {
+	log := r.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request could not be handled",
+			Internal:   "writer is nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	yearNum, ok := parseYear(writer, request, response)
+	if !ok {
+		return
+	}
+
+	setting, err := taxmapper.New(r.db).LoadByYearOrDefault(request.Context(), yearNum)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "RPT-TAX",
+			External:   "failed to calculate home office days",
+			Internal:   "failed to load tax setting",
+			Details:    err,
+		})
+
+		return
+	}
+
+	model := reportmodel.NewHomeOffice(yearNum)
+
+	timelogs, err := timelogmapper.New(r.db).LoadByPeriod(request.Context(), model.FirstDay, model.LastDay)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "RPT-TL",
+			External:   "failed to calculate home office days",
+			Internal:   "failed to load timelogs",
+			Details:    err,
+		})
+
+		return
+	}
+
+	if err := model.Calculate(setting, timelogs); err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "",
+			External:   "failed to calculate home office days",
+			Internal:   "failed to calculate home office days",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jmoiron/sqlx"
 	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
 )
 
 // Init initializes the endpoints regarding reports.
@@ -12,11 +13,75 @@ import (
 func Init(svc *smis.Service, db *sqlx.DB) error {
 	endpoint := &reports{db: db, svc: svc}
 
-	if _, err := svc.RegisterEndpoint("/reports/options", http.MethodGet, endpoint.options); err != nil {
+	route, err := svc.RegisterEndpoint("/reports/options", http.MethodGet, endpoint.options)
+	if err != nil {
 		return err
 	}
 
-	_, err := svc.RegisterEndpoint("/reports/{year}", http.MethodGet, endpoint.reports)
+	auth.RequireScopes(route, auth.ScopeReportsRead)
 
-	return err
+	route, err = svc.RegisterEndpoint("/reports/range/{from}/{to}", http.MethodGet, endpoint.dateRange)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeReportsRead)
+
+	route, err = svc.RegisterEndpoint("/reports/{year}/balance", http.MethodGet, endpoint.balance)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeReportsRead)
+
+	route, err = svc.RegisterEndpoint("/reports/{year}/commute", http.MethodGet, endpoint.commute)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeReportsRead)
+
+	route, err = svc.RegisterEndpoint("/reports/{year}/homeoffice", http.MethodGet, endpoint.homeOffice)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeReportsRead)
+
+	route, err = svc.RegisterEndpoint("/reports/{year}/months/{month}", http.MethodGet, endpoint.month)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeReportsRead)
+
+	route, err = svc.RegisterEndpoint("/reports/{year}/weeks/{week}", http.MethodGet, endpoint.week)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeReportsRead)
+
+	route, err = svc.RegisterEndpoint("/reports/{year}/{month}/timesheet.pdf", http.MethodGet, endpoint.timesheet)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeReportsRead)
+
+	route, err = svc.RegisterEndpoint("/reports/{year}.xlsx", http.MethodGet, endpoint.xlsx)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeReportsRead)
+
+	route, err = svc.RegisterEndpoint("/reports/{year}", http.MethodGet, endpoint.reports)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeReportsRead)
+
+	return nil
 }
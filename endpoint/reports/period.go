@@ -0,0 +1,161 @@
+package reports
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/sirupsen/logrus"
+)
+
+func (r *reports) month(writer http.ResponseWriter, request *http.Request) {
+	log := r.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request could not be handled",
+			Internal:   "writer is nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	yearNum, ok := parseYear(writer, request, response)
+	if !ok {
+		return
+	}
+
+	monthNum, ok := parseNumber(writer, request, response, "month")
+	if !ok {
+		return
+	}
+
+	model, err := reportmodel.NewMonthReport(yearNum, time.Month(monthNum))
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "RPT-WRONGPARAM",
+			External:   err.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	}
+
+	r.calculate(writer, request, response, model)
+}
+
+func (r *reports) week(writer http.ResponseWriter, request *http.Request) {
+	log := r.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request could not be handled",
+			Internal:   "writer is nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	yearNum, ok := parseYear(writer, request, response)
+	if !ok {
+		return
+	}
+
+	weekNum, ok := parseNumber(writer, request, response, "week")
+	if !ok {
+		return
+	}
+
+	model, err := reportmodel.NewWeekReport(yearNum, weekNum)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "RPT-WRONGPARAM",
+			External:   err.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	}
+
+	r.calculate(writer, request, response, model)
+}
+
+func (r *reports) dateRange(writer http.ResponseWriter, request *http.Request) {
+	log := r.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request could not be handled",
+			Internal:   "writer is nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	from, ok := parseDate(writer, request, response, "from")
+	if !ok {
+		return
+	}
+
+	to, ok := parseDate(writer, request, response, "to")
+	if !ok {
+		return
+	}
+
+	model, err := reportmodel.NewRangeReport(from, to)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "RPT-WRONGPARAM",
+			External:   err.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	}
+
+	r.calculate(writer, request, response, model)
+}
@@ -1,16 +1,26 @@
 package reports
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/jmoiron/sqlx"
 	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmodel"
+	"github.com/rebel-l/ttrack_api/endpoint/calendars"
 	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymapper"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
 	"github.com/rebel-l/ttrack_api/report/reportmodel"
 	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/user/usermapper"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
 	"github.com/sirupsen/logrus"
 )
 
@@ -43,35 +53,76 @@ func (r *reports) reports(writer http.ResponseWriter, request *http.Request) {
 		}
 	}(log, request.Body)
 
-	vars := mux.Vars(request)
-	year, ok := vars["year"]
-	if !ok { //nolint: wsl
-		response.WriteJSONError(writer, smis.Error{
-			StatusCode: http.StatusBadRequest,
-			Code:       "RPT-NOPARAM",
-			External:   "no year defined",
-			Internal:   "no year defined",
-			Details:    nil,
-		})
+	yearNum, ok := parseYear(writer, request, response)
+	if !ok {
+		return
+	}
 
+	request, ok = parseUser(writer, request, response, r.db)
+	if !ok {
 		return
 	}
 
-	yearNum, err := strconv.Atoi(year)
-	if err != nil {
+	r.calculate(writer, request, response, reportmodel.NewReport(yearNum))
+}
+
+// calculate loads the public holidays of the selected calendar and the timelogs of the period of the report,
+// calculates the report and writes it to the response.
+func (r *reports) calculate(
+	writer http.ResponseWriter,
+	request *http.Request,
+	response smis.Response,
+	model *reportmodel.Report,
+) {
+	data, ok := r.load(writer, request, response, model.FirstDay, model.LastDay)
+	if !ok {
+		return
+	}
+
+	model.Calendar = data.calendar.Name
+
+	model.UserID, ok = currentUser(writer, request, response)
+	if !ok {
+		return
+	}
+
+	if err := model.Calculate(data.publicHolidays, data.timelogs); err != nil {
 		response.WriteJSONError(writer, smis.Error{
-			StatusCode: http.StatusBadRequest,
-			Code:       "RPT-WRONGPARAM",
-			External:   "cannot parse year",
-			Internal:   "cannot parse year",
+			StatusCode: http.StatusInternalServerError,
+			Code:       "",
+			External:   "failed to calculate report",
+			Internal:   "failed to calculate report",
 			Details:    err,
 		})
 
 		return
 	}
 
+	response.WriteJSON(writer, http.StatusOK, model)
+}
+
+// reportData contains everything loaded to calculate reports of a period.
+type reportData struct {
+	calendar       *calendarmodel.Calendar
+	publicHolidays publicholidaymodel.PublicHolidays
+	timelogs       timelogmodel.Timelogs
+}
+
+// load returns the selected calendar together with its public holidays and the timelogs of the period. If loading
+// fails, an error response is written and false is returned.
+func (r *reports) load(
+	writer http.ResponseWriter,
+	request *http.Request,
+	response smis.Response,
+	firstDay, lastDay time.Time,
+) (*reportData, bool) {
+	calendar, ok := calendars.FromQuery(writer, request, response, r.db)
+	if !ok {
+		return nil, false
+	}
+
 	publicHolidaysMapper := publicholidaymapper.New(r.db)
-	publicHolidays, err := publicHolidaysMapper.LoadByYear(request.Context(), yearNum)
+	publicHolidays, err := publicHolidaysMapper.LoadByPeriod(request.Context(), calendar.ID, firstDay, lastDay)
 	if err != nil {
 		response.WriteJSONError(writer, smis.Error{
 			StatusCode: http.StatusInternalServerError,
@@ -80,10 +131,12 @@ func (r *reports) reports(writer http.ResponseWriter, request *http.Request) {
 			Internal:   "failed to load public holidays",
 			Details:    err,
 		})
+
+		return nil, false
 	}
 
 	timelogsMapper := timelogmapper.New(r.db)
-	timelogs, err := timelogsMapper.LoadByYear(request.Context(), yearNum)
+	timelogs, err := timelogsMapper.LoadByPeriod(request.Context(), firstDay, lastDay)
 	if err != nil {
 		response.WriteJSONError(writer, smis.Error{
 			StatusCode: http.StatusInternalServerError,
@@ -92,20 +145,158 @@ func (r *reports) reports(writer http.ResponseWriter, request *http.Request) {
 			Internal:   "failed to load timelogs",
 			Details:    err,
 		})
+
+		return nil, false
+	}
+
+	return &reportData{calendar: calendar, publicHolidays: publicHolidays, timelogs: timelogs}, true
+}
+
+// parseYear returns the year from the path of the request. If it is missing or invalid, an error response is written
+// and false is returned.
+func parseYear(writer http.ResponseWriter, request *http.Request, response smis.Response) (int, bool) {
+	return parseNumber(writer, request, response, "year")
+}
+
+// parseDate returns the date with the given name from the path of the request. If it is missing or invalid, an error
+// response is written and false is returned.
+func parseDate(writer http.ResponseWriter, request *http.Request, response smis.Response, name string) (time.Time, bool) {
+	vars := mux.Vars(request)
+	value, ok := vars[name]
+	if !ok { //nolint: wsl
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "RPT-NOPARAM",
+			External:   fmt.Sprintf("no %s defined", name),
+			Internal:   fmt.Sprintf("no %s defined", name),
+			Details:    nil,
+		})
+
+		return time.Time{}, false
 	}
 
-	model := reportmodel.NewReport(yearNum)
-	if err := model.Calculate(publicHolidays, timelogs); err != nil {
+	day, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "RPT-WRONGPARAM",
+			External:   fmt.Sprintf("%s should be a date in format %s", name, time.DateOnly),
+			Internal:   fmt.Sprintf("cannot parse %s", name),
+			Details:    err,
+		})
+
+		return time.Time{}, false
+	}
+
+	return day, true
+}
+
+// parseNumber returns the number with the given name from the path of the request. If it is missing or invalid, an
+// error response is written and false is returned.
+func parseNumber(writer http.ResponseWriter, request *http.Request, response smis.Response, name string) (int, bool) {
+	vars := mux.Vars(request)
+	value, ok := vars[name]
+	if !ok { //nolint: wsl
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "RPT-NOPARAM",
+			External:   fmt.Sprintf("no %s defined", name),
+			Internal:   fmt.Sprintf("no %s defined", name),
+			Details:    nil,
+		})
+
+		return 0, false
+	}
+
+	num, err := strconv.Atoi(value)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "RPT-WRONGPARAM",
+			External:   fmt.Sprintf("cannot parse %s", name),
+			Internal:   fmt.Sprintf("cannot parse %s", name),
+			Details:    err,
+		})
+
+		return 0, false
+	}
+
+	return num, true
+}
+
+// parseUser returns the request for the user selected by the query parameter user. Only leads of the team of the user
+// are allowed to select them, the report is calculated for the user of the request otherwise. If the user is invalid
+// or not allowed, an error response is written and false is returned.
+func parseUser(
+	writer http.ResponseWriter,
+	request *http.Request,
+	response smis.Response,
+	db *sqlx.DB,
+) (*http.Request, bool) {
+	value := request.URL.Query().Get("user")
+	if value == "" {
+		return request, true
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "RPT-WRONGPARAM",
+			External:   "user should be a uuid",
+			Internal:   "cannot parse user",
+			Details:    err,
+		})
+
+		return nil, false
+	}
+
+	leadID, ok := currentUser(writer, request, response)
+	if !ok {
+		return nil, false
+	}
+
+	user, err := usermapper.New(db).LoadForLead(request.Context(), leadID, id)
+	if errors.Is(err, usermapper.ErrNotLead) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusForbidden,
+			Code:       "RPT-USR",
+			External:   err.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return nil, false
+	} else if err != nil {
 		response.WriteJSONError(writer, smis.Error{
 			StatusCode: http.StatusInternalServerError,
-			Code:       "",
-			External:   "failed to calculate report",
-			Internal:   "failed to calculate report",
+			Code:       "RPT-USR",
+			External:   "failed to load user",
+			Internal:   "failed to load user",
 			Details:    err,
 		})
 
-		return
+		return nil, false
 	}
 
-	response.WriteJSON(writer, http.StatusOK, model)
+	return request.WithContext(usermodel.NewContext(request.Context(), user.ID)), true
+}
+
+// currentUser returns the ID of the authenticated user of the request. If there is none, an error response is written
+// and false is returned.
+func currentUser(writer http.ResponseWriter, request *http.Request, response smis.Response) (uuid.UUID, bool) {
+	id, err := usermodel.FromContext(request.Context())
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusUnauthorized,
+			Code:       "RPT-USR",
+			External:   "authentication required",
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return uuid.Nil, false
+	}
+
+	return id, true
 }
@@ -0,0 +1,105 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/report/reportpdf"
+	"github.com/sirupsen/logrus"
+)
+
+func (r *reports) timesheet(writer http.ResponseWriter, request *http.Request) {
+	log := r.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request could not be handled",
+			Internal:   "writer is nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	yearNum, ok := parseYear(writer, request, response)
+	if !ok {
+		return
+	}
+
+	monthNum, ok := parseNumber(writer, request, response, "month")
+	if !ok {
+		return
+	}
+
+	model, err := reportmodel.NewTimesheet(yearNum, time.Month(monthNum))
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "RPT-WRONGPARAM",
+			External:   err.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	}
+
+	data, ok := r.load(writer, request, response, model.FirstDay, model.LastDay)
+	if !ok {
+		return
+	}
+
+	model.Calendar = data.calendar.Name
+	if err := model.Calculate(data.publicHolidays, data.timelogs); err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "",
+			External:   "failed to create timesheet",
+			Internal:   "failed to calculate timesheet",
+			Details:    err,
+		})
+
+		return
+	}
+
+	// render to a buffer first, so errors can still be answered as JSON
+	buf := &bytes.Buffer{}
+	if err := reportpdf.WriteTimesheet(buf, model); err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "RPT-PDF",
+			External:   "failed to create timesheet",
+			Internal:   "failed to render timesheet",
+			Details:    err,
+		})
+
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/pdf")
+	writer.Header().Set(
+		"Content-Disposition",
+		fmt.Sprintf("attachment; filename=\"timesheet_%d-%02d.pdf\"", model.Year, model.Month),
+	)
+	writer.WriteHeader(http.StatusOK)
+
+	if _, err := buf.WriteTo(writer); err != nil {
+		log.Errorf("failed to write timesheet: %v", err)
+	}
+}
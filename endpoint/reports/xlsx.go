@@ -0,0 +1,100 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/report/reportxlsx"
+	"github.com/sirupsen/logrus"
+)
+
+const contentTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+func (r *reports) xlsx(writer http.ResponseWriter, request *http.Request) {
+	log := r.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request could not be handled",
+			Internal:   "writer is nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	yearNum, ok := parseYear(writer, request, response)
+	if !ok {
+		return
+	}
+
+	model := reportmodel.NewReport(yearNum)
+	timesheet := reportmodel.NewYearTimesheet(yearNum)
+
+	data, ok := r.load(writer, request, response, model.FirstDay, model.LastDay)
+	if !ok {
+		return
+	}
+
+	model.Calendar = data.calendar.Name
+	timesheet.Calendar = data.calendar.Name
+
+	model.UserID, ok = currentUser(writer, request, response)
+	if !ok {
+		return
+	}
+
+	for _, calculate := range []func() error{
+		func() error { return model.Calculate(data.publicHolidays, data.timelogs) },
+		func() error { return timesheet.Calculate(data.publicHolidays, data.timelogs) },
+	} {
+		if err := calculate(); err != nil {
+			response.WriteJSONError(writer, smis.Error{
+				StatusCode: http.StatusInternalServerError,
+				Code:       "",
+				External:   "failed to calculate report",
+				Internal:   "failed to calculate report",
+				Details:    err,
+			})
+
+			return
+		}
+	}
+
+	// render to a buffer first, so errors can still be answered as JSON
+	buf := &bytes.Buffer{}
+	if err := reportxlsx.WriteReport(buf, model, timesheet, data.timelogs); err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "RPT-XLSX",
+			External:   "failed to create spreadsheet",
+			Internal:   "failed to render spreadsheet",
+			Details:    err,
+		})
+
+		return
+	}
+
+	writer.Header().Set("Content-Type", contentTypeXLSX)
+	writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"report_%d.xlsx\"", model.Year))
+	writer.WriteHeader(http.StatusOK)
+
+	if _, err := buf.WriteTo(writer); err != nil {
+		log.Errorf("failed to write spreadsheet: %v", err)
+	}
+}
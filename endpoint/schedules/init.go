@@ -0,0 +1,45 @@
+package schedules
+
+import (
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
+)
+
+// Init initializes the endpoints to manage work schedules.
+// nolint: wrapcheck,nolintlint
+func Init(svc *smis.Service, db *sqlx.DB) error {
+	endpoint := &schedule{db: db, svc: svc}
+
+	route, err := svc.RegisterEndpoint("/schedules", http.MethodGet, endpoint.loadAll)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeSchedulesRead)
+
+	route, err = svc.RegisterEndpoint("/schedules", http.MethodPut, endpoint.upsert)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeSchedulesWrite)
+
+	route, err = svc.RegisterEndpoint("/schedules/{id}", http.MethodGet, endpoint.load)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeSchedulesRead)
+
+	route, err = svc.RegisterEndpoint("/schedules/{id}", http.MethodDelete, endpoint.delete)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeSchedulesWrite)
+
+	return nil
+}
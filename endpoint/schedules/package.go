@@ -0,0 +1,2 @@
+// Package schedules provide the endpoints to manage work schedules.
+package schedules
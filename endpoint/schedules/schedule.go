@@ -0,0 +1,201 @@
+package schedules
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/schedule/schedulemapper"
+	"github.com/rebel-l/ttrack_api/schedule/schedulemodel"
+	"github.com/sirupsen/logrus"
+)
+
+type schedule struct {
+	db  *sqlx.DB
+	svc *smis.Service
+}
+
+func (s *schedule) upsert(writer http.ResponseWriter, request *http.Request) {
+	log := s.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil || request == nil || request.Body == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request had no data",
+			Internal:   "writer or request nil",
+			Details:    nil,
+		})
+
+		return
+	}
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	model := &schedulemodel.Schedule{} // nolint: exhaustivestruct
+	if err := model.DecodeJSON(request.Body); err != nil {
+		response.WriteJSONError(writer, smis.ErrResponseJSONConversion.WithDetails(err))
+
+		return
+	}
+
+	if err := model.Validate(); err != nil {
+		response.WriteJSONError(writer, smis.Error{ // nolint: exhaustivestruct
+			StatusCode: http.StatusBadRequest,
+			Code:       "VALIDATION",
+			External:   err.Error(),
+		})
+
+		return
+	}
+
+	mapper := schedulemapper.New(s.db)
+
+	model, err := mapper.Save(request.Context(), model)
+	if errors.Is(err, schedulemapper.ErrValidFromExists) {
+		response.WriteJSONError(writer, smis.Error{ // nolint: exhaustivestruct
+			StatusCode: http.StatusConflict,
+			Code:       "SCH-SAVE",
+			External:   err.Error(),
+		})
+
+		return
+	} else if errors.Is(err, schedulemapper.ErrNotFound) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusNotFound,
+			Code:       "SCH-SAVE",
+			External:   schedulemapper.ErrNotFound.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	} else if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "SCH-SAVE",
+			External:   "failed to save schedule",
+			Internal:   "failed to save schedule",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
+
+func (s *schedule) load(writer http.ResponseWriter, request *http.Request) {
+	log := s.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	id, ok := parseID(writer, request, response)
+	if !ok {
+		return
+	}
+
+	mapper := schedulemapper.New(s.db)
+
+	model, err := mapper.Load(request.Context(), id)
+	if errors.Is(err, schedulemapper.ErrNotFound) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusNotFound,
+			Code:       "SCH-LOAD",
+			External:   err.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	} else if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "SCH-LOAD",
+			External:   "failed to load schedule",
+			Internal:   "failed to load schedule",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
+
+func (s *schedule) delete(writer http.ResponseWriter, request *http.Request) {
+	log := s.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	id, ok := parseID(writer, request, response)
+	if !ok {
+		return
+	}
+
+	mapper := schedulemapper.New(s.db)
+	if err := mapper.Delete(request.Context(), id); errors.Is(err, schedulemapper.ErrNotFound) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusNotFound,
+			Code:       "SCH-DELETE",
+			External:   err.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	} else if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "SCH-DELETE",
+			External:   "failed to delete schedule",
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// parseID returns the id from the path of the request. If it is missing or invalid, an error response is written
+// and false is returned.
+func parseID(writer http.ResponseWriter, request *http.Request, response smis.Response) (uuid.UUID, bool) {
+	vars := mux.Vars(request)
+	id, ok := vars["id"]
+	if !ok { //nolint: wsl
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "no id defined",
+			Internal:   "no id defined",
+			Details:    nil,
+		})
+
+		return uuid.Nil, false
+	}
+
+	idParsed, err := uuid.Parse(id)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "no id defined",
+			Internal:   "id not a uuid",
+			Details:    err,
+		})
+
+		return uuid.Nil, false
+	}
+
+	return idParsed, true
+}
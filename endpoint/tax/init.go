@@ -0,0 +1,31 @@
+package tax
+
+import (
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
+)
+
+// Init initializes the endpoints regarding tax settings.
+// nolint: wrapcheck,nolintlint
+func Init(svc *smis.Service, db *sqlx.DB) error {
+	endpoint := &tax{db: db, svc: svc}
+
+	route, err := svc.RegisterEndpoint("/tax/settings", http.MethodPut, endpoint.upsert)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTaxWrite)
+
+	route, err = svc.RegisterEndpoint("/tax/settings/{year}", http.MethodGet, endpoint.load)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTaxRead)
+
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package tax provide the endpoints to manage the yearly tax settings.
+package tax
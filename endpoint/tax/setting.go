@@ -0,0 +1,174 @@
+package tax
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/tax/taxmapper"
+	"github.com/rebel-l/ttrack_api/tax/taxmodel"
+	"github.com/sirupsen/logrus"
+)
+
+type tax struct {
+	db  *sqlx.DB
+	svc *smis.Service
+}
+
+// upsert saves the tax setting of a year. Values missing in the body are taken from the stored setting of the year or
+// from the defaults.
+func (t *tax) upsert(writer http.ResponseWriter, request *http.Request) {
+	log := t.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil || request == nil || request.Body == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request had no data",
+			Internal:   "writer or request nil",
+			Details:    nil,
+		})
+
+		return
+	}
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		response.WriteJSONError(writer, smis.ErrResponseJSONConversion.WithDetails(err))
+
+		return
+	}
+
+	// the year is needed first to take the stored setting or the defaults for all values missing in the body
+	year := &taxmodel.Setting{} // nolint: exhaustivestruct
+	if err := year.DecodeJSON(bytes.NewReader(body)); err != nil {
+		response.WriteJSONError(writer, smis.ErrResponseJSONConversion.WithDetails(err))
+
+		return
+	}
+
+	mapper := taxmapper.New(t.db)
+
+	model, err := mapper.LoadByYearOrDefault(request.Context(), year.Year)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "TAX-LOAD",
+			External:   "failed to load tax setting",
+			Internal:   "failed to load tax setting",
+			Details:    err,
+		})
+
+		return
+	}
+
+	if err := model.DecodeJSON(bytes.NewReader(body)); err != nil {
+		response.WriteJSONError(writer, smis.ErrResponseJSONConversion.WithDetails(err))
+
+		return
+	}
+
+	if err := model.Validate(); err != nil {
+		response.WriteJSONError(writer, smis.Error{ // nolint: exhaustivestruct
+			StatusCode: http.StatusBadRequest,
+			Code:       "VALIDATION",
+			External:   err.Error(),
+		})
+
+		return
+	}
+
+	model, err = mapper.Save(request.Context(), model)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		external := "failed to save tax setting"
+
+		switch {
+		case errors.Is(err, taxmapper.ErrNotFound):
+			statusCode = http.StatusNotFound
+			external = err.Error()
+		case errors.Is(err, taxmapper.ErrDuplicateYear):
+			statusCode = http.StatusConflict
+			external = err.Error()
+		}
+
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: statusCode,
+			Code:       "TAX-SAVE",
+			External:   external,
+			Internal:   "failed to save tax setting",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
+
+// load returns the tax setting of the year. Without a stored setting the defaults are returned.
+func (t *tax) load(writer http.ResponseWriter, request *http.Request) {
+	log := t.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request could not be handled",
+			Internal:   "writer is nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	yearNum, err := strconv.Atoi(mux.Vars(request)["year"])
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "TAX-WRONGPARAM",
+			External:   "cannot parse year",
+			Internal:   "cannot parse year",
+			Details:    err,
+		})
+
+		return
+	}
+
+	model, err := taxmapper.New(t.db).LoadByYearOrDefault(request.Context(), yearNum)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "TAX-LOAD",
+			External:   "failed to load tax setting",
+			Internal:   "failed to load tax setting",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
@@ -0,0 +1,48 @@
+package teams
+
+import (
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+// Init initializes the endpoints to manage teams.
+// nolint: wrapcheck,nolintlint
+func Init(svc *smis.Service, db *sqlx.DB) error {
+	endpoint := &team{db: db, svc: svc}
+
+	route, err := svc.RegisterEndpoint("/teams", http.MethodGet, endpoint.loadAll)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTeamsRead)
+
+	route, err = svc.RegisterEndpoint("/teams", http.MethodPut, endpoint.upsert)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTeamsWrite)
+	auth.RequireRoles(route, usermodel.RoleAdmin)
+
+	route, err = svc.RegisterEndpoint("/teams/{id}", http.MethodGet, endpoint.load)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTeamsRead)
+
+	route, err = svc.RegisterEndpoint("/teams/{id}", http.MethodDelete, endpoint.delete)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTeamsWrite)
+	auth.RequireRoles(route, usermodel.RoleAdmin)
+
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package teams provide the endpoints to manage teams.
+package teams
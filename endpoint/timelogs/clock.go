@@ -0,0 +1,174 @@
+package timelogs
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/sirupsen/logrus"
+)
+
+func (t *timelog) clockIn(writer http.ResponseWriter, request *http.Request) {
+	log := t.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil || request == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request had no data",
+			Internal:   "writer or request nil",
+			Details:    nil,
+		})
+
+		return
+	}
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	// body is optional, it can provide reason and location
+	model := &timelogmodel.Timelog{} // nolint: exhaustivestruct
+	if err := model.DecodeJSON(request.Body); err != nil && !errors.Is(err, io.EOF) {
+		response.WriteJSONError(writer, smis.ErrResponseJSONConversion.WithDetails(err))
+
+		return
+	}
+
+	model.Start = time.Now()
+
+	if model.Reason == "" {
+		model.Reason = timelogmodel.ReasonWork
+	}
+
+	if model.Location == "" {
+		model.Location = timelogmodel.LocationHome
+	}
+
+	if err := model.Validate(); err != nil {
+		response.WriteJSONError(writer, smis.Error{ // nolint: exhaustivestruct
+			StatusCode: http.StatusBadRequest,
+			Code:       "VALIDATION",
+			External:   err.Error(),
+		})
+
+		return
+	}
+
+	mapper := timelogmapper.New(t.db)
+
+	model, err := mapper.ClockIn(request.Context(), model)
+	if writeOverlap(writer, response, err) {
+		return
+	} else if err != nil {
+		response.WriteJSONError(writer, clockError("CLOCK-IN", "failed to clock in", err))
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
+
+func (t *timelog) clockOut(writer http.ResponseWriter, request *http.Request) {
+	log := t.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil || request == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request had no data",
+			Internal:   "writer or request nil",
+			Details:    nil,
+		})
+
+		return
+	}
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	mapper := timelogmapper.New(t.db)
+
+	model, err := mapper.ClockOut(request.Context(), time.Now())
+	if writeOverlap(writer, response, err) {
+		return
+	} else if err != nil {
+		response.WriteJSONError(writer, clockError("CLOCK-OUT", "failed to clock out", err))
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
+
+func (t *timelog) current(writer http.ResponseWriter, request *http.Request) {
+	log := t.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil || request == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request had no data",
+			Internal:   "writer or request nil",
+			Details:    nil,
+		})
+
+		return
+	}
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	mapper := timelogmapper.New(t.db)
+
+	model, err := mapper.LoadCurrent(request.Context())
+	if err != nil {
+		response.WriteJSONError(writer, clockError("CURRENT", "failed to load running timelog", err))
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
+
+// clockError maps the errors of the clock functions of the mapper to a proper response.
+func clockError(code, msg string, err error) smis.Error {
+	statusCode := http.StatusInternalServerError
+	external := msg
+
+	switch {
+	case errors.Is(err, timelogmapper.ErrNotClockedIn):
+		statusCode = http.StatusNotFound
+		external = err.Error()
+	case errors.Is(err, timelogmapper.ErrAlreadyClockedIn), errors.Is(err, timelogmapper.ErrMultipleRunning),
+		errors.Is(err, timelogmapper.ErrStopBeforeStart), errors.Is(err, timelogmapper.ErrOverlap):
+		statusCode = http.StatusConflict
+		external = err.Error()
+	}
+
+	return smis.Error{
+		StatusCode: statusCode,
+		Code:       code,
+		External:   external,
+		Internal:   msg,
+		Details:    err,
+	}
+}
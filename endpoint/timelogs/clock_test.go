@@ -0,0 +1,123 @@
+package timelogs // nolint: testpackage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rebel-l/go-utils/osutils"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/config"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+	"github.com/sirupsen/logrus"
+)
+
+func setup(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+
+	// 0. init path
+	storagePath := filepath.Join(".", "..", "..", "storage", "test_timelogs", name)
+	scriptPath := filepath.Join(".", "..", "..", "scripts", "sql", "sqlite")
+	conf := &config.Database{
+		StoragePath:       &storagePath,
+		SchemaScriptsPath: &scriptPath,
+	}
+
+	// 1. clean up
+	if osutils.FileOrPathExists(conf.GetStoragePath()) {
+		if err := os.RemoveAll(conf.GetStoragePath()); err != nil {
+			t.Fatalf("failed to cleanup test files: %v", err)
+		}
+	}
+
+	// 2. init database
+	db, err := bootstrap.Database(conf, "0.0.0", false)
+	if err != nil {
+		t.Fatalf("No error expected: %v", err)
+	}
+
+	return db
+}
+
+func TestTimelog_ClockOutOverlap(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "clockOutOverlap")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	svc, err := smis.NewService(&http.Server{}, mux.NewRouter(), logrus.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+	mapper := timelogmapper.New(db)
+	now := time.Now()
+
+	_, err = mapper.ClockIn(ctx, &timelogmodel.Timelog{
+		Start:    now.Add(-2 * time.Hour),
+		Reason:   timelogmodel.ReasonWork,
+		Location: timelogmodel.LocationHome,
+	})
+	if err != nil {
+		t.Fatalf("failed to prepare running timelog: %v", err)
+	}
+
+	// the break lies inside the running timelog, but ends after the clock out
+	breakStop := now.Add(time.Hour)
+
+	conflict, err := mapper.Save(ctx, &timelogmodel.Timelog{
+		Start:    now.Add(-time.Hour),
+		Stop:     &breakStop,
+		Reason:   timelogmodel.ReasonBreak,
+		Location: timelogmodel.LocationHome,
+	})
+	if err != nil {
+		t.Fatalf("failed to prepare break: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/timelogs/clock-out", http.NoBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+
+	// 2. test
+	ep := &timelog{db: db, svc: svc}
+	http.HandlerFunc(ep.clockOut).ServeHTTP(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected status code %d but got %d: %s", http.StatusConflict, w.Code, w.Body.String())
+	}
+
+	actual := conflictResponse{}
+	if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+		t.Fatalf("expected no error on decoding but got '%v'", err)
+	}
+
+	if actual.Code != "OVERLAP" || len(actual.IDs) != 1 || actual.IDs[0] != conflict.ID {
+		t.Errorf("expected overlap with %s but got %+v", conflict.ID, actual)
+	}
+}
@@ -0,0 +1,114 @@
+package timelogs
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	exportFormatCSV    = "csv"
+	exportFormatNDJSON = "ndjson"
+)
+
+func (t *timelog) export(writer http.ResponseWriter, request *http.Request) {
+	log := t.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil || request == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request had no data",
+			Internal:   "writer or request nil",
+			Details:    nil,
+		})
+
+		return
+	}
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	query := request.URL.Query()
+	from := query.Get("from")
+	to := query.Get("to")
+	days := make(map[string]time.Time)
+
+	for name, v := range map[string]string{"from": from, "to": to} {
+		day, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			response.WriteJSONError(writer, smis.Error{
+				StatusCode: http.StatusBadRequest,
+				Code:       "EXPORT",
+				External:   fmt.Sprintf("%s must be a date in format YYYY-MM-DD", name),
+				Internal:   fmt.Sprintf("cannot parse %s", name),
+				Details:    err,
+			})
+
+			return
+		}
+
+		days[name] = day
+	}
+
+	format := query.Get("format")
+	if format == "" {
+		format = exportFormatCSV
+	}
+
+	var write func(*timelogmodel.Timelog) error
+
+	var flush func() error
+
+	switch format {
+	case exportFormatCSV:
+		w := timelogmodel.NewCSVWriter(writer)
+		write = w.Write
+		flush = w.Flush
+
+		writer.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"timelogs_%s_%s.csv\"", from, to))
+
+		if err := w.WriteHeader(); err != nil {
+			log.Errorf("failed to write CSV header: %v", err)
+
+			return
+		}
+	case exportFormatNDJSON:
+		w := timelogmodel.NewNDJSONWriter(writer)
+		write = w.Write
+		flush = func() error { return nil }
+
+		writer.Header().Set("Content-Type", "application/x-ndjson")
+	default:
+		response.WriteJSONError(writer, smis.Error{ // nolint: exhaustivestruct
+			StatusCode: http.StatusBadRequest,
+			Code:       "EXPORT",
+			External:   fmt.Sprintf("format must be %q or %q", exportFormatCSV, exportFormatNDJSON),
+			Internal:   "unknown format",
+		})
+
+		return
+	}
+
+	// the status is sent with the first bytes, so errors while streaming can only be logged
+	mapper := timelogmapper.New(t.db)
+	if err := mapper.StreamByPeriod(request.Context(), days["from"], days["to"], write); err != nil {
+		log.Errorf("failed to export timelogs: %v", err)
+	}
+
+	if err := flush(); err != nil {
+		log.Errorf("failed to flush export: %v", err)
+	}
+}
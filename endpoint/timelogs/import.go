@@ -0,0 +1,85 @@
+package timelogs
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/sirupsen/logrus"
+)
+
+func (t *timelog) importCSV(writer http.ResponseWriter, request *http.Request) {
+	log := t.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil || request == nil || request.Body == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request had no data",
+			Internal:   "writer or request nil",
+			Details:    nil,
+		})
+
+		return
+	}
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	dryRun := false
+
+	if v := request.URL.Query().Get("dryRun"); v != "" {
+		var err error
+
+		dryRun, err = strconv.ParseBool(v)
+		if err != nil {
+			response.WriteJSONError(writer, smis.Error{
+				StatusCode: http.StatusBadRequest,
+				Code:       "IMPORT",
+				External:   "dryRun must be a boolean",
+				Internal:   "cannot parse dryRun",
+				Details:    err,
+			})
+
+			return
+		}
+	}
+
+	rows, err := timelogmodel.ReadCSV(request.Body)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "IMPORT",
+			External:   err.Error(),
+			Internal:   "failed to read CSV",
+			Details:    err,
+		})
+
+		return
+	}
+
+	mapper := timelogmapper.New(t.db)
+
+	model, err := mapper.Import(request.Context(), rows, dryRun)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "IMPORT",
+			External:   "failed to import timelogs",
+			Internal:   "failed to import timelogs",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
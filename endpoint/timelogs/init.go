@@ -5,21 +5,69 @@ import (
 
 	"github.com/jmoiron/sqlx"
 	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
 )
 
 // Init initializes the endpoints to log times.
+// nolint: wrapcheck,nolintlint
 func Init(svc *smis.Service, db *sqlx.DB) error {
 	endpoint := &timelog{db: db, svc: svc}
 
-	if _, err := svc.RegisterEndpoint("/timgelogs", http.MethodPut, endpoint.upsert); err != nil {
+	route, err := svc.RegisterEndpoint("/timgelogs", http.MethodPut, endpoint.upsert)
+	if err != nil {
 		return err
 	}
 
-	if _, err := svc.RegisterEndpoint("/timgelogs/{id}", http.MethodDelete, endpoint.delete); err != nil {
+	auth.RequireScopes(route, auth.ScopeTimelogsWrite)
+
+	route, err = svc.RegisterEndpoint("/timgelogs/{id}", http.MethodDelete, endpoint.delete)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTimelogsWrite)
+
+	route, err = svc.RegisterEndpoint("/timelogs/clock-in", http.MethodPost, endpoint.clockIn)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTimelogsWrite)
+
+	route, err = svc.RegisterEndpoint("/timelogs/clock-out", http.MethodPost, endpoint.clockOut)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTimelogsWrite)
+
+	route, err = svc.RegisterEndpoint("/timelogs/current", http.MethodGet, endpoint.current)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTimelogsRead)
+
+	route, err = svc.RegisterEndpoint("/timelogs/import", http.MethodPost, endpoint.importCSV)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTimelogsWrite)
+
+	route, err = svc.RegisterEndpoint("/timelogs/export", http.MethodGet, endpoint.export)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTimelogsRead)
+
+	route, err = svc.RegisterEndpoint("/timelogs/{start}/{stop}", http.MethodGet, endpoint.loadByRange)
+	if err != nil {
 		return err
 	}
 
-	_, err := svc.RegisterEndpoint("/timelogs/{start}/{stop}", http.MethodGet, endpoint.loadByRange)
+	auth.RequireScopes(route, auth.ScopeTimelogsRead)
 
-	return err // nolint: wrapcheck
+	return nil
 }
@@ -1,6 +1,7 @@
 package timelogs
 
 import (
+	"errors"
 	"io"
 	"net/http"
 
@@ -18,6 +19,13 @@ type timelog struct {
 	svc *smis.Service
 }
 
+// conflictResponse is the error response if a timelog overlaps with existing ones.
+type conflictResponse struct {
+	Code  string      `json:"code"`
+	Error string      `json:"error"`
+	IDs   []uuid.UUID `json:"ids"`
+}
+
 func (t *timelog) upsert(writer http.ResponseWriter, request *http.Request) {
 	log := t.svc.NewLogForRequestID(request.Context())
 	response := smis.Response{Log: log}
@@ -61,7 +69,28 @@ func (t *timelog) upsert(writer http.ResponseWriter, request *http.Request) {
 	mapper := timelogmapper.New(t.db)
 
 	model, err := mapper.Save(request.Context(), model)
-	if err != nil {
+
+	if writeOverlap(writer, response, err) {
+		return
+	} else if errors.Is(err, timelogmapper.ErrNotFound) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusNotFound,
+			Code:       "SAVE",
+			External:   timelogmapper.ErrNotFound.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	} else if errors.Is(err, timelogmapper.ErrProjectNotFound) || errors.Is(err, timelogmapper.ErrOfficeNotFound) {
+		response.WriteJSONError(writer, smis.Error{ // nolint: exhaustivestruct
+			StatusCode: http.StatusBadRequest,
+			Code:       "VALIDATION",
+			External:   err.Error(),
+		})
+
+		return
+	} else if err != nil {
 		response.WriteJSONError(writer, smis.Error{
 			StatusCode: http.StatusInternalServerError,
 			Code:       "SAVE",
@@ -108,7 +137,17 @@ func (t *timelog) delete(writer http.ResponseWriter, request *http.Request) {
 	}
 
 	mapper := timelogmapper.New(t.db)
-	if err := mapper.Delete(request.Context(), idParsed); err != nil {
+	if err := mapper.Delete(request.Context(), idParsed); errors.Is(err, timelogmapper.ErrNotFound) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusNotFound,
+			Code:       "",
+			External:   err.Error(),
+			Internal:   err.Error(),
+			Details:    nil,
+		})
+
+		return
+	} else if err != nil {
 		response.WriteJSONError(writer, smis.Error{
 			StatusCode: http.StatusInternalServerError,
 			Code:       "",
@@ -122,3 +161,20 @@ func (t *timelog) delete(writer http.ResponseWriter, request *http.Request) {
 
 	writer.WriteHeader(http.StatusNoContent)
 }
+
+// writeOverlap writes the conflict response and returns true if the error is caused by overlapping timelogs.
+func writeOverlap(writer http.ResponseWriter, response smis.Response, err error) bool {
+	var overlapErr *timelogmapper.OverlapError
+	if !errors.As(err, &overlapErr) {
+		return false
+	}
+
+	response.Log.Warn(overlapErr.Error())
+	response.WriteJSON(writer, http.StatusConflict, conflictResponse{
+		Code:  "OVERLAP",
+		Error: timelogmapper.ErrOverlap.Error(),
+		IDs:   overlapErr.IDs,
+	})
+
+	return true
+}
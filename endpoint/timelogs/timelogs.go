@@ -62,7 +62,7 @@ func (t *timelog) loadByRange(writer http.ResponseWriter, request *http.Request)
 
 	mapper := timelogmapper.New(t.db)
 
-	model, err := mapper.LoadByDateRange(request.Context(), start, stop)
+	model, err := mapper.LoadByDateRange(request.Context(), start, stop, request.URL.Query()["tag"]...)
 	if err != nil {
 		response.WriteJSONError(writer, smis.Error{
 			StatusCode: http.StatusInternalServerError,
@@ -0,0 +1,31 @@
+package tokens
+
+import (
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
+)
+
+// Init initializes the endpoints to manage personal API tokens.
+// nolint: wrapcheck,nolintlint
+func Init(svc *smis.Service, db *sqlx.DB) error {
+	endpoint := &token{db: db, svc: svc}
+
+	route, err := svc.RegisterEndpoint("/tokens", http.MethodPost, endpoint.create)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTokensWrite)
+
+	route, err = svc.RegisterEndpoint("/tokens/{id}", http.MethodDelete, endpoint.delete)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeTokensWrite)
+
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package tokens provide the endpoints to manage personal API tokens.
+package tokens
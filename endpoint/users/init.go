@@ -0,0 +1,48 @@
+package users
+
+import (
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+// Init initializes the endpoints to manage users.
+// nolint: wrapcheck,nolintlint
+func Init(svc *smis.Service, db *sqlx.DB) error {
+	endpoint := &user{db: db, svc: svc}
+
+	route, err := svc.RegisterEndpoint("/users", http.MethodGet, endpoint.loadAll)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeUsersRead)
+
+	route, err = svc.RegisterEndpoint("/users", http.MethodPut, endpoint.upsert)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeUsersWrite)
+	auth.RequireRoles(route, usermodel.RoleAdmin)
+
+	route, err = svc.RegisterEndpoint("/users/{id}", http.MethodGet, endpoint.load)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeUsersRead)
+
+	route, err = svc.RegisterEndpoint("/users/{id}", http.MethodDelete, endpoint.delete)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeUsersWrite)
+	auth.RequireRoles(route, usermodel.RoleAdmin)
+
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package users provide the endpoints to manage users.
+package users
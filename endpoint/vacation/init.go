@@ -0,0 +1,31 @@
+package vacation
+
+import (
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
+)
+
+// Init initializes the endpoints regarding vacation.
+// nolint: wrapcheck,nolintlint
+func Init(svc *smis.Service, db *sqlx.DB) error {
+	endpoint := &vacation{db: db, svc: svc}
+
+	route, err := svc.RegisterEndpoint("/vacation", http.MethodPut, endpoint.upsert)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeVacationWrite)
+
+	route, err = svc.RegisterEndpoint("/vacation/{year}", http.MethodGet, endpoint.report)
+	if err != nil {
+		return err
+	}
+
+	auth.RequireScopes(route, auth.ScopeVacationRead)
+
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package vacation provide the endpoints to manage vacation entitlements and to report vacation days.
+package vacation
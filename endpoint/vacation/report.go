@@ -0,0 +1,121 @@
+package vacation
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/endpoint/calendars"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymapper"
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/vacation/vacationmapper"
+	"github.com/sirupsen/logrus"
+)
+
+func (v *vacation) report(writer http.ResponseWriter, request *http.Request) {
+	log := v.svc.NewLogForRequestID(request.Context())
+	response := smis.Response{Log: log}
+
+	if writer == nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "",
+			External:   "request could not be handled",
+			Internal:   "writer is nil",
+			Details:    nil,
+		})
+
+		return
+	}
+
+	defer func(log logrus.FieldLogger, c ...io.Closer) {
+		for _, v := range c {
+			if err := v.Close(); err != nil {
+				log.Warnf("failed to close: %v", err)
+			}
+		}
+	}(log, request.Body)
+
+	yearNum, err := strconv.Atoi(mux.Vars(request)["year"])
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "VAC-WRONGPARAM",
+			External:   "cannot parse year",
+			Internal:   "cannot parse year",
+			Details:    err,
+		})
+
+		return
+	}
+
+	// without entitlement the vacation days are reported anyway
+	entitlement, err := vacationmapper.New(v.db).LoadByYear(request.Context(), yearNum)
+	if err != nil && !errors.Is(err, vacationmapper.ErrNotFound) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "VAC-ENT",
+			External:   "failed to calculate vacation",
+			Internal:   "failed to load vacation entitlement",
+			Details:    err,
+		})
+
+		return
+	}
+
+	calendar, ok := calendars.FromQuery(writer, request, response, v.db)
+	if !ok {
+		return
+	}
+
+	publicHolidays, err := publicholidaymapper.New(v.db).LoadByYear(request.Context(), calendar.ID, yearNum)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "VAC-PHL",
+			External:   "failed to calculate vacation",
+			Internal:   "failed to load public holidays",
+			Details:    err,
+		})
+
+		return
+	}
+
+	// vacations started in the previous year can reach into this one, the calculation clips them to the year
+	timelogs, err := timelogmapper.New(v.db).LoadByPeriod(
+		request.Context(),
+		time.Date(yearNum-1, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(yearNum, 12, 31, 0, 0, 0, 0, time.UTC),
+	)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "VAC-TL",
+			External:   "failed to calculate vacation",
+			Internal:   "failed to load timelogs",
+			Details:    err,
+		})
+
+		return
+	}
+
+	model := reportmodel.NewVacation(yearNum)
+	if err := model.Calculate(entitlement, publicHolidays, timelogs, time.Now()); err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "",
+			External:   "failed to calculate vacation",
+			Internal:   "failed to calculate vacation",
+			Details:    err,
+		})
+
+		return
+	}
+
+	response.WriteJSON(writer, http.StatusOK, model)
+}
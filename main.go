@@ -4,6 +4,7 @@ Backend Service of a time tracking application.
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net/http"
@@ -17,11 +18,25 @@ import (
 	"github.com/rebel-l/smis/middleware/cors"
 	"github.com/rebel-l/ttrack_api/bootstrap"
 	"github.com/rebel-l/ttrack_api/config"
+	"github.com/rebel-l/ttrack_api/endpoint/calendars"
+	"github.com/rebel-l/ttrack_api/endpoint/commute"
 	"github.com/rebel-l/ttrack_api/endpoint/doc"
 	"github.com/rebel-l/ttrack_api/endpoint/ping"
+	"github.com/rebel-l/ttrack_api/endpoint/projects"
 	"github.com/rebel-l/ttrack_api/endpoint/publicholiday"
 	"github.com/rebel-l/ttrack_api/endpoint/reports"
+	"github.com/rebel-l/ttrack_api/endpoint/schedules"
+	"github.com/rebel-l/ttrack_api/endpoint/tax"
+	"github.com/rebel-l/ttrack_api/endpoint/teams"
 	"github.com/rebel-l/ttrack_api/endpoint/timelogs"
+	"github.com/rebel-l/ttrack_api/endpoint/tokens"
+	"github.com/rebel-l/ttrack_api/endpoint/users"
+	"github.com/rebel-l/ttrack_api/endpoint/vacation"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
+	"github.com/rebel-l/ttrack_api/token/tokenmapper"
+	"github.com/rebel-l/ttrack_api/token/tokenmodel"
+	"github.com/rebel-l/ttrack_api/user/usermapper"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
 	"github.com/sirupsen/logrus"
 )
 
@@ -33,16 +48,28 @@ const (
 )
 
 var (
-	db   *sqlx.DB
-	log  logrus.FieldLogger
-	port *int
-	svc  *smis.Service
+	db          *sqlx.DB
+	log         logrus.FieldLogger
+	port        *int
+	svc         *smis.Service
+	createToken *string
+	jwtConfig   = &config.JWT{} // nolint: exhaustivestruct
+	jwt         *auth.JWT
 )
 
 func initCustomFlags() {
 	/**
 	  1. Add your custom service flags below, for more details see https://golang.org/pkg/flag/
 	*/
+	createToken = flag.String(
+		"create-token",
+		"",
+		"creates an API token for the user with the given name, prints it and exits without starting the service",
+	)
+	jwtConfig.Secret = flag.String("jwt-secret", "", "the shared secret to validate JWTs signed with HS256")
+	jwtConfig.JWKSPath = flag.String("jwks", "", "the path to the JWKS file to validate JWTs signed with RS256")
+	jwtConfig.Issuer = flag.String("jwt-issuer", "", "the expected issuer of JWTs, not checked if empty")
+	jwtConfig.Audience = flag.String("jwt-audience", "", "the expected audience of JWTs, not checked if empty")
 }
 
 func initCustom() error {
@@ -57,6 +84,14 @@ func initCustom() error {
 		return fmt.Errorf("bootstrapping database failed: %w", err)
 	}
 
+	// JWT
+	if jwtConfig.IsEnabled() {
+		jwt, err = auth.NewJWT(jwtConfig)
+		if err != nil {
+			return fmt.Errorf("initialising JWT validation failed: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -68,6 +103,9 @@ func initCustomRoutes() error {
 	} // TODO: make it configurable
 	svc.WithDefaultMiddleware(c) // TODO: add catch panic middleware to default
 
+	// all endpoints except of the public ones require authentication
+	svc.AddMiddlewareForDefaultChain(auth.New(svc, db, jwt, "/ping", "/doc"))
+
 	/**
 	  3. Register your custom routes below
 	*/
@@ -83,6 +121,42 @@ func initCustomRoutes() error {
 		return fmt.Errorf("failed to init the publicholiday endpoints: %w", err)
 	}
 
+	if err := projects.Init(svc, db); err != nil {
+		return fmt.Errorf("failed to init the projects endpoints: %w", err)
+	}
+
+	if err := schedules.Init(svc, db); err != nil {
+		return fmt.Errorf("failed to init the schedules endpoints: %w", err)
+	}
+
+	if err := vacation.Init(svc, db); err != nil {
+		return fmt.Errorf("failed to init the vacation endpoints: %w", err)
+	}
+
+	if err := calendars.Init(svc, db); err != nil {
+		return fmt.Errorf("failed to init the calendars endpoints: %w", err)
+	}
+
+	if err := tax.Init(svc, db); err != nil {
+		return fmt.Errorf("failed to init the tax endpoints: %w", err)
+	}
+
+	if err := commute.Init(svc, db); err != nil {
+		return fmt.Errorf("failed to init the commute endpoints: %w", err)
+	}
+
+	if err := users.Init(svc, db); err != nil {
+		return fmt.Errorf("failed to init the users endpoints: %w", err)
+	}
+
+	if err := tokens.Init(svc, db); err != nil {
+		return fmt.Errorf("failed to init the tokens endpoints: %w", err)
+	}
+
+	if err := teams.Init(svc, db); err != nil {
+		return fmt.Errorf("failed to init the teams endpoints: %w", err)
+	}
+
 	return nil
 }
 
@@ -97,6 +171,14 @@ func main() {
 		log.Fatalf("Failed to initialise custom settings: %s", err)
 	}
 
+	if *createToken != "" {
+		if err := printToken(*createToken); err != nil {
+			log.Fatalf("Failed to create token: %s", err)
+		}
+
+		return
+	}
+
 	if err := initRoutes(); err != nil {
 		log.Fatalf("Failed to initialise routes: %s", err)
 	}
@@ -107,6 +189,29 @@ func main() {
 	}
 }
 
+// printToken creates an API token for the user with the given name and prints it. It allows to get the first token
+// as all endpoints to manage tokens require authentication.
+func printToken(userName string) error {
+	ctx := context.Background()
+
+	user, err := usermapper.New(db).LoadByName(ctx, userName)
+	if err != nil {
+		return fmt.Errorf("failed to load user %q: %w", userName, err)
+	}
+
+	token, err := tokenmapper.New(db).Create(
+		usermodel.NewContext(ctx, user.ID),
+		&tokenmodel.Token{Name: "created by command line"}, // nolint: exhaustivestruct
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save token: %w", err)
+	}
+
+	fmt.Println(token.Secret) // nolint: forbidigo
+
+	return nil
+}
+
 func initService() {
 	router := mux.NewRouter()
 	srv := &http.Server{ // nolint: exhaustivestruct
@@ -0,0 +1,245 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/token/tokenmapper"
+	"github.com/rebel-l/ttrack_api/token/tokenmodel"
+	"github.com/rebel-l/ttrack_api/user/usermapper"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+const (
+	// HeaderAuthorization is the key of the header containing the token.
+	HeaderAuthorization = "Authorization"
+
+	// SchemeBearer is the authentication scheme expected in front of the token.
+	SchemeBearer = "Bearer"
+
+	headerAuthenticate = "WWW-Authenticate"
+)
+
+// ErrUnknownSubject is the error if the subject of a JWT doesn't belong to a user.
+var ErrUnknownSubject = errors.New("subject of token is not a known user")
+
+type auth struct {
+	db     *sqlx.DB
+	svc    *smis.Service
+	jwt    *JWT
+	public []string
+}
+
+// identity is the result of a successful authentication.
+type identity struct {
+	userID uuid.UUID
+	scopes []string
+	scoped bool // true if access is limited to scopes
+}
+
+// New returns the middleware rejecting requests without a valid token, except of requests to the public path prefixes
+// and preflight requests. The token is expected as bearer token in the Authorization header. It is either an API token
+// or a JWT validated by jwt. JWTs are rejected if jwt is nil. Requests lacking the scopes or roles of the route are
+// forbidden. For authenticated requests the user of the token and the scopes it is limited to are attached to the
+// context of the request.
+func New(svc *smis.Service, db *sqlx.DB, jwt *JWT, public ...string) mux.MiddlewareFunc {
+	mw := &auth{db: db, svc: svc, jwt: jwt, public: public}
+
+	return mw.handler
+}
+
+func (a *auth) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method == http.MethodOptions || a.isPublic(request.URL.Path) {
+			next.ServeHTTP(writer, request)
+
+			return
+		}
+
+		log := a.svc.NewLogForRequestID(request.Context())
+		response := smis.Response{Log: log}
+
+		secret, ok := bearerToken(request)
+		if !ok {
+			writer.Header().Set(headerAuthenticate, SchemeBearer)
+			response.WriteJSONError(writer, smis.Error{
+				StatusCode: http.StatusUnauthorized,
+				Code:       "AUTH-MISSING",
+				External:   "authentication required",
+				Internal:   "no bearer token in request",
+				Details:    nil,
+			})
+
+			return
+		}
+
+		id, err := a.authenticate(request.Context(), secret)
+		if errors.Is(err, tokenmapper.ErrInvalidToken) || errors.Is(err, ErrInvalidJWT) ||
+			errors.Is(err, ErrJWTDisabled) || errors.Is(err, ErrUnknownSubject) {
+			writer.Header().Set(headerAuthenticate, SchemeBearer+` error="invalid_token"`)
+			response.WriteJSONError(writer, smis.Error{
+				StatusCode: http.StatusUnauthorized,
+				Code:       "AUTH-INVALID",
+				External:   "invalid token",
+				Internal:   err.Error(),
+				Details:    nil,
+			})
+
+			return
+		} else if err != nil {
+			response.WriteJSONError(writer, smis.Error{
+				StatusCode: http.StatusInternalServerError,
+				Code:       "AUTH-LOAD",
+				External:   "failed to authenticate",
+				Internal:   "failed to load token",
+				Details:    err,
+			})
+
+			return
+		}
+
+		if req := registry.get(mux.CurrentRoute(request)); req != nil && !a.authorise(writer, request, response, req, id) {
+			return
+		}
+
+		ctx := usermodel.NewContext(request.Context(), id.userID)
+		if id.scoped {
+			ctx = tokenmodel.NewScopesContext(ctx, id.scopes)
+		}
+
+		next.ServeHTTP(writer, request.WithContext(ctx))
+	})
+}
+
+// authorise checks that the identity fulfills the requirement of the route. If not, an error response is written and
+// false is returned.
+func (a *auth) authorise(
+	writer http.ResponseWriter,
+	request *http.Request,
+	response smis.Response,
+	req *requirement,
+	id *identity,
+) bool {
+	if missing := missingScopes(req.scopes, id.scopes); id.scoped && len(missing) > 0 {
+		scope := strings.Join(missing, " ")
+		writer.Header().Set(headerAuthenticate, fmt.Sprintf(`%s error="insufficient_scope", scope=%q`, SchemeBearer, scope))
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusForbidden,
+			Code:       "AUTH-SCOPE",
+			External:   "missing scope: " + scope,
+			Internal:   "missing scope: " + scope,
+			Details:    nil,
+		})
+
+		return false
+	}
+
+	if len(req.roles) == 0 {
+		return true
+	}
+
+	user, err := usermapper.New(a.db).Load(request.Context(), id.userID)
+	if err != nil {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusInternalServerError,
+			Code:       "AUTH-LOAD",
+			External:   "failed to authorise",
+			Internal:   "failed to load user",
+			Details:    err,
+		})
+
+		return false
+	}
+
+	if !user.HasRole(req.roles...) {
+		response.WriteJSONError(writer, smis.Error{
+			StatusCode: http.StatusForbidden,
+			Code:       "AUTH-ROLE",
+			External:   "missing role: " + strings.Join(req.roles, " or "),
+			Internal:   fmt.Sprintf("user %s has role %q", user.ID, user.Role),
+			Details:    nil,
+		})
+
+		return false
+	}
+
+	return true
+}
+
+// authenticate returns the identity of the token. API tokens are recognised by their prefix and grant their scopes or
+// all if they have none, any other token is validated as JWT and grants only its scopes.
+func (a *auth) authenticate(ctx context.Context, secret string) (*identity, error) {
+	if strings.HasPrefix(secret, tokenmodel.SecretPrefix) {
+		token, err := tokenmapper.New(a.db).Authenticate(ctx, secret)
+		if err != nil {
+			return nil, err // nolint: wrapcheck
+		}
+
+		return &identity{userID: token.UserID, scopes: token.Scopes, scoped: token.IsScoped()}, nil
+	}
+
+	if a.jwt == nil {
+		return nil, ErrJWTDisabled
+	}
+
+	claims, err := a.jwt.Verify(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := a.userBySubject(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity{userID: user.ID, scopes: claims.Scopes(), scoped: true}, nil
+}
+
+// userBySubject returns the user the subject belongs to. The subject must be the ID of the user, names are not
+// accepted as they can be changed and reused.
+func (a *auth) userBySubject(ctx context.Context, subject string) (*usermodel.User, error) {
+	id, err := uuid.Parse(subject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSubject, subject)
+	}
+
+	user, err := usermapper.New(a.db).Load(ctx, id)
+	if errors.Is(err, usermapper.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSubject, subject)
+	} else if err != nil {
+		return nil, err // nolint: wrapcheck
+	}
+
+	return user, nil
+}
+
+// isPublic returns true if the path is one of the public prefixes or below of one of them.
+func (a *auth) isPublic(path string) bool {
+	for _, v := range a.public {
+		if path == v || strings.HasPrefix(path, v+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bearerToken returns the token of the Authorization header. It returns false if the header is missing or uses
+// another scheme.
+func bearerToken(request *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(request.Header.Get(HeaderAuthorization), " ")
+	if !ok || !strings.EqualFold(scheme, SchemeBearer) {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, token != ""
+}
@@ -0,0 +1,431 @@
+package auth_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rebel-l/go-utils/osutils"
+	"github.com/rebel-l/smis"
+	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/config"
+	"github.com/rebel-l/ttrack_api/endpoint/tokens"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
+	"github.com/rebel-l/ttrack_api/token/tokenmapper"
+	"github.com/rebel-l/ttrack_api/token/tokenmodel"
+	"github.com/rebel-l/ttrack_api/user/usermapper"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+	"github.com/sirupsen/logrus"
+)
+
+func setup(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+
+	// 0. init path
+	storagePath := filepath.Join(".", "..", "..", "storage", "test_auth", name)
+	scriptPath := filepath.Join(".", "..", "..", "scripts", "sql", "sqlite")
+	conf := &config.Database{
+		StoragePath:       &storagePath,
+		SchemaScriptsPath: &scriptPath,
+	}
+
+	// 1. clean up
+	if osutils.FileOrPathExists(conf.GetStoragePath()) {
+		if err := os.RemoveAll(conf.GetStoragePath()); err != nil {
+			t.Fatalf("failed to cleanup test files: %v", err)
+		}
+	}
+
+	// 2. init database
+	db, err := bootstrap.Database(conf, "0.0.0", false)
+	if err != nil {
+		t.Fatalf("No error expected: %v", err)
+	}
+
+	return db
+}
+
+func TestAuth(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "auth")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	user, err := usermapper.New(db).Save(context.Background(), &usermodel.User{Name: "Jane"})
+	if err != nil {
+		t.Fatalf("failed to prepare user: %v", err)
+	}
+
+	ctx := usermodel.NewContext(context.Background(), user.ID)
+	tMapper := tokenmapper.New(db)
+
+	valid, err := tMapper.Create(ctx, &tokenmodel.Token{Name: "laptop"})
+	if err != nil {
+		t.Fatalf("failed to prepare token: %v", err)
+	}
+
+	revoked, err := tMapper.Create(ctx, &tokenmodel.Token{Name: "old laptop"})
+	if err != nil {
+		t.Fatalf("failed to prepare token: %v", err)
+	}
+
+	if err := tMapper.Delete(ctx, revoked.ID); err != nil {
+		t.Fatalf("failed to revoke token: %v", err)
+	}
+
+	admin, err := tMapper.Create(
+		usermodel.NewContext(context.Background(), usermodel.DefaultID),
+		&tokenmodel.Token{Name: "admin laptop"},
+	)
+	if err != nil {
+		t.Fatalf("failed to prepare token: %v", err)
+	}
+
+	svc, err := smis.NewService(&http.Server{}, mux.NewRouter(), logrus.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := testSecret
+
+	jwt, err := auth.NewJWT(&config.JWT{Secret: &secret})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc.AddMiddlewareForDefaultChain(auth.New(svc, db, jwt, "/ping"))
+
+	handler := func(writer http.ResponseWriter, request *http.Request) {
+		id, err := usermodel.FromContext(request.Context())
+		if err != nil {
+			_, _ = writer.Write([]byte("anonymous"))
+
+			return
+		}
+
+		_, _ = writer.Write([]byte(id.String()))
+	}
+
+	if _, err := svc.RegisterEndpoint("/ping", http.MethodGet, handler); err != nil {
+		t.Fatal(err)
+	}
+
+	route, err := svc.RegisterEndpoint("/timelogs/current", http.MethodGet, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auth.RequireScopes(route, auth.ScopeTimelogsRead)
+
+	route, err = svc.RegisterEndpoint("/holidays", http.MethodGet, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auth.RequireScopes(route, auth.ScopeHolidaysAdmin)
+
+	route, err = svc.RegisterEndpoint("/holidays", http.MethodPut, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auth.RequireScopes(route, auth.ScopeHolidaysAdmin)
+	auth.RequireRoles(route, usermodel.RoleAdmin)
+
+	// 2. test
+	subject := user.ID.String()
+	adminSubject := usermodel.DefaultID.String()
+	testCases := []struct {
+		name          string
+		method        string
+		path          string
+		authorization string
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:         "public without token",
+			method:       http.MethodGet,
+			path:         "/ping",
+			expectedCode: http.StatusOK,
+			expectedBody: "anonymous",
+		},
+		{
+			name:         "protected without token",
+			method:       http.MethodGet,
+			path:         "/timelogs/current",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "preflight without token",
+			method:       http.MethodOptions,
+			path:         "/timelogs/current",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:          "protected with other scheme",
+			method:        http.MethodGet,
+			path:          "/timelogs/current",
+			authorization: "Basic " + valid.Secret,
+			expectedCode:  http.StatusUnauthorized,
+		},
+		{
+			name:          "protected with unknown token",
+			method:        http.MethodGet,
+			path:          "/timelogs/current",
+			authorization: "Bearer ttrack_unknown",
+			expectedCode:  http.StatusUnauthorized,
+		},
+		{
+			name:          "protected with revoked token",
+			method:        http.MethodGet,
+			path:          "/timelogs/current",
+			authorization: "Bearer " + revoked.Secret,
+			expectedCode:  http.StatusUnauthorized,
+		},
+		{
+			name:          "protected with valid token",
+			method:        http.MethodGet,
+			path:          "/timelogs/current",
+			authorization: "Bearer " + valid.Secret,
+			expectedCode:  http.StatusOK,
+			expectedBody:  user.ID.String(),
+		},
+		{
+			name:         "scoped route without token",
+			method:       http.MethodGet,
+			path:         "/holidays",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "scoped route with valid token",
+			method:        http.MethodGet,
+			path:          "/holidays",
+			authorization: "Bearer " + valid.Secret,
+			expectedCode:  http.StatusOK,
+			expectedBody:  user.ID.String(),
+		},
+		{
+			name:          "JWT with subject as name",
+			method:        http.MethodGet,
+			path:          "/timelogs/current",
+			authorization: "Bearer " + signHS256(t, secret, claims("Jane", time.Hour, auth.ScopeTimelogsRead)),
+			expectedCode:  http.StatusUnauthorized,
+		},
+		{
+			name:          "JWT with subject as id",
+			method:        http.MethodGet,
+			path:          "/timelogs/current",
+			authorization: "Bearer " + signHS256(t, secret, claims(subject, time.Hour, auth.ScopeTimelogsRead)),
+			expectedCode:  http.StatusOK,
+			expectedBody:  user.ID.String(),
+		},
+		{
+			name:          "JWT with unknown subject",
+			method:        http.MethodGet,
+			path:          "/timelogs/current",
+			authorization: "Bearer " + signHS256(t, secret, claims(uuid.NewString(), time.Hour, auth.ScopeTimelogsRead)),
+			expectedCode:  http.StatusUnauthorized,
+		},
+		{
+			name:          "JWT with invalid signature",
+			method:        http.MethodGet,
+			path:          "/timelogs/current",
+			authorization: "Bearer " + signHS256(t, "other secret", claims(subject, time.Hour, auth.ScopeTimelogsRead)),
+			expectedCode:  http.StatusUnauthorized,
+		},
+		{
+			name:          "JWT without required scope",
+			method:        http.MethodGet,
+			path:          "/timelogs/current",
+			authorization: "Bearer " + signHS256(t, secret, claims(subject, time.Hour, auth.ScopeReportsRead)),
+			expectedCode:  http.StatusForbidden,
+		},
+		{
+			name:          "JWT with required scope",
+			method:        http.MethodGet,
+			path:          "/holidays",
+			authorization: "Bearer " + signHS256(t, secret, claims(subject, time.Hour, auth.ScopeHolidaysAdmin)),
+			expectedCode:  http.StatusOK,
+			expectedBody:  user.ID.String(),
+		},
+		{
+			name:          "role required without role",
+			method:        http.MethodPut,
+			path:          "/holidays",
+			authorization: "Bearer " + valid.Secret,
+			expectedCode:  http.StatusForbidden,
+		},
+		{
+			name:          "role required with role",
+			method:        http.MethodPut,
+			path:          "/holidays",
+			authorization: "Bearer " + admin.Secret,
+			expectedCode:  http.StatusOK,
+			expectedBody:  usermodel.DefaultID.String(),
+		},
+		{
+			name:          "role required with role but JWT without scope",
+			method:        http.MethodPut,
+			path:          "/holidays",
+			authorization: "Bearer " + signHS256(t, secret, claims(adminSubject, time.Hour, "")),
+			expectedCode:  http.StatusForbidden,
+		},
+		{
+			name:          "role required with role and JWT with scope",
+			method:        http.MethodPut,
+			path:          "/holidays",
+			authorization: "Bearer " + signHS256(t, secret, claims(adminSubject, time.Hour, auth.ScopeHolidaysAdmin)),
+			expectedCode:  http.StatusOK,
+			expectedBody:  usermodel.DefaultID.String(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(context.Background(), testCase.method, testCase.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if testCase.authorization != "" {
+				req.Header.Set(auth.HeaderAuthorization, testCase.authorization)
+			}
+
+			w := httptest.NewRecorder()
+			svc.Router.ServeHTTP(w, req)
+
+			if w.Code != testCase.expectedCode {
+				t.Errorf("expected status code %d but got %d", testCase.expectedCode, w.Code)
+			}
+
+			body, _ := io.ReadAll(w.Body)
+			if testCase.expectedBody != "" && string(body) != testCase.expectedBody {
+				t.Errorf("expected body %q but got %q", testCase.expectedBody, string(body))
+			}
+		})
+	}
+}
+
+func TestAuth_TokenScopes(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "tokenScopes")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	svc, err := smis.NewService(&http.Server{}, mux.NewRouter(), logrus.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := testSecret
+
+	jwt, err := auth.NewJWT(&config.JWT{Secret: &secret})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc.AddMiddlewareForDefaultChain(auth.New(svc, db, jwt))
+
+	if err := tokens.Init(svc, db); err != nil {
+		t.Fatal(err)
+	}
+
+	handler := func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	}
+
+	route, err := svc.RegisterEndpoint("/timelogs/current", http.MethodGet, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auth.RequireScopes(route, auth.ScopeTimelogsRead)
+
+	serve := func(method, path, authorization, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequestWithContext(context.Background(), method, path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req.Header.Set(auth.HeaderAuthorization, "Bearer "+authorization)
+
+		w := httptest.NewRecorder()
+		svc.Router.ServeHTTP(w, req)
+
+		return w
+	}
+
+	subject := usermodel.DefaultID.String()
+	tokensOnly := signHS256(t, secret, claims(subject, time.Hour, auth.ScopeTokensWrite))
+
+	// 2. test
+	withTimelogs := `{"Name": "timelogs", "Scopes": ["timelogs:read"]}`
+	if w := serve(http.MethodPost, "/tokens", tokensOnly, withTimelogs); w.Code != http.StatusForbidden {
+		t.Errorf("expected status code %d for scope not granted but got %d", http.StatusForbidden, w.Code)
+	}
+
+	w := serve(http.MethodPost, "/tokens", tokensOnly, `{"Name": "inherit"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status code %d on create but got %d", http.StatusOK, w.Code)
+	}
+
+	created := &tokenmodel.Token{}
+	if err := created.DecodeJSON(w.Body); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []string{auth.ScopeTokensWrite}; !reflect.DeepEqual(expected, created.Scopes) {
+		t.Errorf("expected token to inherit scopes %v but got %v", expected, created.Scopes)
+	}
+
+	if w := serve(http.MethodGet, "/timelogs/current", created.Secret, ""); w.Code != http.StatusForbidden {
+		t.Errorf("expected status code %d for token created by scoped JWT but got %d", http.StatusForbidden, w.Code)
+	}
+
+	full := signHS256(t, secret, claims(subject, time.Hour, auth.ScopeTokensWrite+" "+auth.ScopeTimelogsRead))
+	if w := serve(http.MethodPost, "/tokens", full, withTimelogs); w.Code != http.StatusOK {
+		t.Fatalf("expected status code %d on create but got %d", http.StatusOK, w.Code)
+	} else if err := created.DecodeJSON(w.Body); err != nil {
+		t.Fatal(err)
+	}
+
+	if w := serve(http.MethodGet, "/timelogs/current", created.Secret, ""); w.Code != http.StatusOK {
+		t.Errorf("expected status code %d for token with scope but got %d", http.StatusOK, w.Code)
+	}
+}
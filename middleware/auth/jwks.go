@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+const (
+	keyTypeRSA = "RSA"
+	keyUseSig  = "sig"
+)
+
+var (
+	// ErrJWKSLoad is the error if the JWKS file can't be read or decoded.
+	ErrJWKSLoad = errors.New("failed to load JWKS")
+
+	// ErrJWKSInvalidKey is the error if a key of the JWKS file has no valid modulus or exponent.
+	ErrJWKSInvalidKey = errors.New("invalid RSA key")
+
+	// ErrJWKSNoKeys is the error if the JWKS file contains no key usable to verify signatures by RS256.
+	ErrJWKSNoKeys = errors.New("JWKS contains no RSA signing keys")
+)
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+type jwk struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Modulus   string `json:"n"`
+	Exponent  string `json:"e"`
+}
+
+// loadJWKS returns the RSA public keys of the JWKS file by their key id. Keys of other types or usages are skipped.
+func loadJWKS(path string) (map[string]*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrJWKSLoad, err)
+	}
+
+	var set jwks
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrJWKSLoad, err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+
+	for _, v := range set.Keys {
+		if v.KeyType != keyTypeRSA || (v.Use != "" && v.Use != keyUseSig) || (v.Algorithm != "" && v.Algorithm != algRS256) {
+			continue
+		}
+
+		key, err := v.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("%w: key %q: %v", ErrJWKSLoad, v.KeyID, err)
+		}
+
+		keys[v.KeyID] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, ErrJWKSNoKeys
+	}
+
+	return keys, nil
+}
+
+// publicKey returns the RSA public key build from modulus and exponent.
+func (k jwk) publicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.Modulus)
+	if err != nil {
+		return nil, fmt.Errorf("%w: modulus: %v", ErrJWKSInvalidKey, err)
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(k.Exponent)
+	if err != nil {
+		return nil, fmt.Errorf("%w: exponent: %v", ErrJWKSInvalidKey, err)
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 2 || exponent.Int64() > 1<<31-1 {
+		return nil, ErrJWKSInvalidKey
+	}
+
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
@@ -0,0 +1,243 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/config"
+)
+
+const (
+	algHS256 = "HS256"
+	algRS256 = "RS256"
+)
+
+var (
+	// ErrInvalidJWT is the error if the token is malformed, its signature doesn't match or its claims are not valid.
+	ErrInvalidJWT = errors.New("invalid JWT")
+
+	// ErrJWTDisabled is the error if neither a secret nor a JWKS file is configured.
+	ErrJWTDisabled = errors.New("JWT validation is not configured")
+)
+
+// JWT validates JSON Web Tokens signed with HS256 or RS256.
+type JWT struct {
+	secret   []byte
+	keys     map[string]*rsa.PublicKey
+	issuer   string
+	audience string
+	now      func() time.Time
+}
+
+// Claims represents the registered claims of a token used by the service and its scopes.
+type Claims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt *int64   `json:"exp"`
+	NotBefore *int64   `json:"nbf"`
+	Scope     string   `json:"scope"`
+	Scp       []string `json:"scp"`
+}
+
+type header struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+// audience is the aud claim, which is either a single string or a list of strings.
+type audience []string
+
+// UnmarshalJSON decodes the audience from a string or a list of strings.
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("aud is neither a string nor a list of strings: %w", err)
+	}
+
+	*a = list
+
+	return nil
+}
+
+// NewJWT returns a JWT validator for the configuration. It loads the public keys from the JWKS file if configured.
+func NewJWT(cfg *config.JWT) (*JWT, error) {
+	if !cfg.IsEnabled() {
+		return nil, ErrJWTDisabled
+	}
+
+	j := &JWT{
+		issuer:   cfg.GetIssuer(),
+		audience: cfg.GetAudience(),
+		now:      time.Now,
+	}
+
+	if cfg.GetSecret() != "" {
+		j.secret = []byte(cfg.GetSecret())
+	}
+
+	if cfg.GetJWKSPath() != "" {
+		keys, err := loadJWKS(cfg.GetJWKSPath())
+		if err != nil {
+			return nil, err
+		}
+
+		j.keys = keys
+	}
+
+	return j, nil
+}
+
+// Verify checks signature, expiry, issuer and audience of the token and returns its claims. The expiry and subject
+// claims are required.
+func (j *JWT) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 { // nolint: gomnd
+		return nil, fmt.Errorf("%w: token has not three parts", ErrInvalidJWT)
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, fmt.Errorf("%w: header: %v", ErrInvalidJWT, err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %v", ErrInvalidJWT, err)
+	}
+
+	if err := j.verifySignature(h, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	claims := &Claims{} // nolint: exhaustivestruct
+	if err := decodeSegment(parts[1], claims); err != nil {
+		return nil, fmt.Errorf("%w: claims: %v", ErrInvalidJWT, err)
+	}
+
+	if err := j.validate(claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// verifySignature checks the signature by the algorithm of the header. The secret is only used for HS256 and the
+// keys only for RS256, so a token can't choose how it gets verified.
+func (j *JWT) verifySignature(h header, signed string, signature []byte) error {
+	digest := sha256.Sum256([]byte(signed))
+
+	switch h.Algorithm {
+	case algHS256:
+		if j.secret == nil {
+			return fmt.Errorf("%w: algorithm %s is not configured", ErrInvalidJWT, h.Algorithm)
+		}
+
+		mac := hmac.New(sha256.New, j.secret)
+		_, _ = mac.Write([]byte(signed))
+
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return fmt.Errorf("%w: signature mismatch", ErrInvalidJWT)
+		}
+	case algRS256:
+		key, err := j.key(h.KeyID)
+		if err != nil {
+			return err
+		}
+
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidJWT, err)
+		}
+	default:
+		return fmt.Errorf("%w: algorithm %q is not supported", ErrInvalidJWT, h.Algorithm)
+	}
+
+	return nil
+}
+
+// key returns the public key with the key id. Tokens without key id are accepted if there is only one key.
+func (j *JWT) key(id string) (*rsa.PublicKey, error) {
+	if key, ok := j.keys[id]; ok {
+		return key, nil
+	}
+
+	if id == "" && len(j.keys) == 1 {
+		for _, key := range j.keys {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidJWT, id)
+}
+
+// validate checks the registered claims.
+func (j *JWT) validate(claims *Claims) error {
+	now := j.now().Unix()
+
+	if claims.ExpiresAt == nil || now >= *claims.ExpiresAt {
+		return fmt.Errorf("%w: token is expired or has no expiry", ErrInvalidJWT)
+	}
+
+	if claims.NotBefore != nil && now < *claims.NotBefore {
+		return fmt.Errorf("%w: token is not valid yet", ErrInvalidJWT)
+	}
+
+	if claims.Subject == "" {
+		return fmt.Errorf("%w: token has no subject", ErrInvalidJWT)
+	}
+
+	if j.issuer != "" && claims.Issuer != j.issuer {
+		return fmt.Errorf("%w: unexpected issuer %q", ErrInvalidJWT, claims.Issuer)
+	}
+
+	if j.audience != "" && !contains(claims.Audience, j.audience) {
+		return fmt.Errorf("%w: token is not issued for audience %q", ErrInvalidJWT, j.audience)
+	}
+
+	return nil
+}
+
+// Scopes returns the scopes granted by the token. They are read from the space separated scope claim and the scp
+// claim used by some identity providers.
+func (c *Claims) Scopes() []string {
+	return append(strings.Fields(c.Scope), c.Scp...)
+}
+
+// decodeSegment decodes a base64url encoded JSON segment of the token into v.
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return fmt.Errorf("failed to decode: %w", err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,266 @@
+package auth_test
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/config"
+	"github.com/rebel-l/ttrack_api/middleware/auth"
+)
+
+const testSecret = "a-secret-only-known-by-the-identity-provider"
+
+func encodeSegment(t *testing.T, v any) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to encode segment: %v", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signHS256(t *testing.T, secret string, claims map[string]any) string {
+	t.Helper()
+
+	signed := encodeSegment(t, map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(signed))
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+
+	header := map[string]string{"alg": "RS256", "typ": "JWT", "kid": kid}
+	signed := encodeSegment(t, header) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signed))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func writeJWKS(t *testing.T, kid string, key *rsa.PublicKey) string {
+	t.Helper()
+
+	jwks := map[string]any{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": kid,
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			},
+		},
+	}
+
+	data, err := json.Marshal(jwks)
+	if err != nil {
+		t.Fatalf("failed to encode JWKS: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write JWKS: %v", err)
+	}
+
+	return path
+}
+
+func claims(subject string, expiresIn time.Duration, scope string) map[string]any {
+	return map[string]any{
+		"sub":   subject,
+		"iss":   "https://idp.example.com",
+		"aud":   []string{"ttrack_api", "other"},
+		"exp":   time.Now().Add(expiresIn).Unix(),
+		"scope": scope,
+	}
+}
+
+func TestNewJWT(t *testing.T) {
+	t.Parallel()
+
+	if _, err := auth.NewJWT(&config.JWT{}); !errors.Is(err, auth.ErrJWTDisabled) {
+		t.Errorf("expected error '%v' but got '%v'", auth.ErrJWTDisabled, err)
+	}
+
+	notExisting := filepath.Join(t.TempDir(), "not_existing.json")
+	if _, err := auth.NewJWT(&config.JWT{JWKSPath: &notExisting}); !errors.Is(err, auth.ErrJWKSLoad) {
+		t.Errorf("expected error '%v' but got '%v'", auth.ErrJWKSLoad, err)
+	}
+
+	empty := filepath.Join(t.TempDir(), "empty.json")
+	if err := os.WriteFile(empty, []byte(`{"keys":[{"kty":"EC","kid":"ec"}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := auth.NewJWT(&config.JWT{JWKSPath: &empty}); !errors.Is(err, auth.ErrJWKSNoKeys) {
+		t.Errorf("expected error '%v' but got '%v'", auth.ErrJWKSNoKeys, err)
+	}
+}
+
+func TestJWT_Verify(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	secret := testSecret
+	jwksPath := writeJWKS(t, "key-1", &key.PublicKey)
+	issuer := "https://idp.example.com"
+	audience := "ttrack_api"
+
+	jwt, err := auth.NewJWT(&config.JWT{Secret: &secret, JWKSPath: &jwksPath, Issuer: &issuer, Audience: &audience})
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	valid := claims("Jane", time.Hour, "timelogs:read reports:read")
+
+	withoutSubject := claims("", time.Hour, "")
+	notYetValid := claims("Jane", time.Hour, "")
+	notYetValid["nbf"] = time.Now().Add(time.Minute).Unix()
+	otherIssuer := claims("Jane", time.Hour, "")
+	otherIssuer["iss"] = "https://other.example.com"
+	otherAudience := claims("Jane", time.Hour, "")
+	otherAudience["aud"] = "other"
+	withoutExpiry := claims("Jane", time.Hour, "")
+	delete(withoutExpiry, "exp")
+
+	testCases := []struct {
+		name          string
+		token         string
+		expectedError error
+	}{
+		{
+			name:  "HS256",
+			token: signHS256(t, secret, valid),
+		},
+		{
+			name:  "RS256",
+			token: signRS256(t, key, "key-1", valid),
+		},
+		{
+			name:  "RS256 without key id",
+			token: signRS256(t, key, "", valid),
+		},
+		{
+			name:          "HS256 with other secret",
+			token:         signHS256(t, "other secret", valid),
+			expectedError: auth.ErrInvalidJWT,
+		},
+		{
+			name:          "RS256 with other key",
+			token:         signRS256(t, otherKey, "key-1", valid),
+			expectedError: auth.ErrInvalidJWT,
+		},
+		{
+			name:          "RS256 with unknown key id",
+			token:         signRS256(t, key, "key-2", valid),
+			expectedError: auth.ErrInvalidJWT,
+		},
+		{
+			name:          "algorithm none",
+			token:         encodeSegment(t, map[string]string{"alg": "none"}) + "." + encodeSegment(t, valid) + ".",
+			expectedError: auth.ErrInvalidJWT,
+		},
+		{
+			name:          "malformed",
+			token:         "not.a-token",
+			expectedError: auth.ErrInvalidJWT,
+		},
+		{
+			name:          "expired",
+			token:         signHS256(t, secret, claims("Jane", -time.Minute, "")),
+			expectedError: auth.ErrInvalidJWT,
+		},
+		{
+			name:          "without expiry",
+			token:         signHS256(t, secret, withoutExpiry),
+			expectedError: auth.ErrInvalidJWT,
+		},
+		{
+			name:          "not yet valid",
+			token:         signHS256(t, secret, notYetValid),
+			expectedError: auth.ErrInvalidJWT,
+		},
+		{
+			name:          "without subject",
+			token:         signHS256(t, secret, withoutSubject),
+			expectedError: auth.ErrInvalidJWT,
+		},
+		{
+			name:          "other issuer",
+			token:         signHS256(t, secret, otherIssuer),
+			expectedError: auth.ErrInvalidJWT,
+		},
+		{
+			name:          "other audience",
+			token:         signHS256(t, secret, otherAudience),
+			expectedError: auth.ErrInvalidJWT,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := jwt.Verify(testCase.token)
+			if !errors.Is(err, testCase.expectedError) {
+				t.Fatalf("expected error '%v' but got '%v'", testCase.expectedError, err)
+			}
+
+			if testCase.expectedError != nil {
+				return
+			}
+
+			if got.Subject != "Jane" {
+				t.Errorf("expected subject 'Jane' but got '%s'", got.Subject)
+			}
+
+			scopes := got.Scopes()
+			if len(scopes) != 2 || scopes[0] != auth.ScopeTimelogsRead || scopes[1] != auth.ScopeReportsRead {
+				t.Errorf("expected scopes '%s' and '%s' but got %v", auth.ScopeTimelogsRead, auth.ScopeReportsRead, scopes)
+			}
+		})
+	}
+}
+
+func TestClaims_Scopes(t *testing.T) {
+	t.Parallel()
+
+	c := &auth.Claims{Scope: " timelogs:read  reports:read ", Scp: []string{"holidays:admin"}}
+
+	got := c.Scopes()
+	if len(got) != 3 || got[0] != "timelogs:read" || got[1] != "reports:read" || got[2] != "holidays:admin" {
+		t.Errorf("expected scopes of scope and scp claim but got %v", got)
+	}
+}
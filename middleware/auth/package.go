@@ -0,0 +1,3 @@
+// Package auth provides the middleware to authenticate requests by personal API tokens or JWTs and to authorise them
+// by the scopes and roles required by the routes.
+package auth
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"sync"
+
+	"github.com/gorilla/mux"
+)
+
+// requirement contains what a request needs to access a route besides authentication.
+type requirement struct {
+	scopes []string
+	roles  []string
+}
+
+type routeRegistry struct {
+	mu     sync.RWMutex
+	routes map[*mux.Route]*requirement
+}
+
+var registry = &routeRegistry{routes: make(map[*mux.Route]*requirement)} // nolint: gochecknoglobals
+
+// add changes the requirement of the route by f.
+func (r *routeRegistry) add(route *mux.Route, f func(req *requirement)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	req, ok := r.routes[route]
+	if !ok {
+		req = &requirement{} // nolint: exhaustivestruct
+		r.routes[route] = req
+	}
+
+	f(req)
+}
+
+// get returns the requirement of the route or nil if there is none.
+func (r *routeRegistry) get(route *mux.Route) *requirement {
+	if route == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.routes[route]
+}
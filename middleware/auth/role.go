@@ -0,0 +1,12 @@
+package auth
+
+import (
+	"github.com/gorilla/mux"
+)
+
+// RequireRoles registers the roles of which the user of the token must have one to access the route.
+func RequireRoles(route *mux.Route, roles ...string) {
+	registry.add(route, func(req *requirement) {
+		req.roles = append(req.roles, roles...)
+	})
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"github.com/gorilla/mux"
+)
+
+// Scopes a JWT needs to access the routes requiring them.
+const (
+	ScopeTimelogsRead   = "timelogs:read"
+	ScopeTimelogsWrite  = "timelogs:write"
+	ScopeReportsRead    = "reports:read"
+	ScopeHolidaysRead   = "holidays:read"
+	ScopeHolidaysAdmin  = "holidays:admin"
+	ScopeTokensWrite    = "tokens:write"
+	ScopeCalendarsRead  = "calendars:read"
+	ScopeCalendarsWrite = "calendars:write"
+	ScopeProjectsRead   = "projects:read"
+	ScopeProjectsWrite  = "projects:write"
+	ScopeSchedulesRead  = "schedules:read"
+	ScopeSchedulesWrite = "schedules:write"
+	ScopeVacationRead   = "vacation:read"
+	ScopeVacationWrite  = "vacation:write"
+	ScopeTaxRead        = "tax:read"
+	ScopeTaxWrite       = "tax:write"
+	ScopeCommuteRead    = "commute:read"
+	ScopeCommuteWrite   = "commute:write"
+	ScopeUsersRead      = "users:read"
+	ScopeUsersWrite     = "users:write"
+	ScopeTeamsRead      = "teams:read"
+	ScopeTeamsWrite     = "teams:write"
+)
+
+// RequireScopes registers the scopes a JWT must grant to access the route. API tokens are only limited by scopes if
+// they were created with some.
+func RequireScopes(route *mux.Route, scopes ...string) {
+	registry.add(route, func(req *requirement) {
+		req.scopes = append(req.scopes, scopes...)
+	})
+}
+
+// missingScopes returns the required scopes which are not granted.
+func missingScopes(required, granted []string) []string {
+	var missing []string
+
+	for _, v := range required {
+		if !contains(granted, v) {
+			missing = append(missing, v)
+		}
+	}
+
+	return missing
+}
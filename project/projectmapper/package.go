@@ -0,0 +1,2 @@
+// Package projectmapper provides functionality to read and persist projects.
+package projectmapper
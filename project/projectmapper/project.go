@@ -0,0 +1,113 @@
+package projectmapper
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+	"github.com/rebel-l/ttrack_api/project/projectmodel"
+	"github.com/rebel-l/ttrack_api/project/projectstore"
+)
+
+var (
+	// ErrLoadFromDB occurs if something went wrong on loading.
+	ErrLoadFromDB = errors.New("failed to load project from database")
+
+	// ErrNoData occurs if given model is nil.
+	ErrNoData = errors.New("project is nil")
+
+	// ErrSaveToDB occurs if something went wrong on saving.
+	ErrSaveToDB = errors.New("failed to save project to database")
+
+	// ErrDeleteFromDB occurs if something went wrong on deleting.
+	ErrDeleteFromDB = errors.New("failed to delete project from database")
+
+	// ErrNotFound occurs if record doesn't exist in database.
+	ErrNotFound = errors.New("project was not found")
+)
+
+// Mapper provides methods to load and persist project models.
+type Mapper struct {
+	db *sqlx.DB
+}
+
+// New returns a new mapper.
+func New(db *sqlx.DB) *Mapper {
+	return &Mapper{db: db}
+}
+
+// Load returns a project model loaded from database by ID.
+func (m *Mapper) Load(ctx context.Context, id uuid.UUID) (*projectmodel.Project, error) {
+	s := &projectstore.Project{ID: id} // nolint: exhaustivestruct
+
+	if err := s.Read(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// Save persists (create or update) the model and returns the changed data (id, createdAt or modifiedAt).
+func (m *Mapper) Save(ctx context.Context, model *projectmodel.Project) (*projectmodel.Project, error) {
+	if model == nil {
+		return nil, ErrNoData
+	}
+
+	s := modelToStore(model)
+
+	if uuidutils.IsEmpty(model.ID) {
+		if err := s.Create(ctx, m.db); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	} else {
+		if err := s.Update(ctx, m.db); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	}
+
+	model = StoreToModel(s)
+
+	return model, nil
+}
+
+// Delete removes a model from database by ID. Timelogs assigned to the project lose their assignment.
+func (m *Mapper) Delete(ctx context.Context, id uuid.UUID) error {
+	s := &projectstore.Project{ID: id} // nolint: exhaustivestruct
+	if err := s.Delete(ctx, m.db); err != nil {
+		return fmt.Errorf("%w: %v", ErrDeleteFromDB, err)
+	}
+
+	return nil
+}
+
+// StoreToModel returns a model based on the given store object. It maps all properties from store to model.
+func StoreToModel(s *projectstore.Project) *projectmodel.Project {
+	if s == nil {
+		return &projectmodel.Project{} // nolint: exhaustivestruct
+	}
+
+	return &projectmodel.Project{
+		ID:         s.ID,
+		Name:       s.Name,
+		Customer:   s.Customer,
+		CreatedAt:  s.CreatedAt,
+		ModifiedAt: s.ModifiedAt,
+	}
+}
+
+// modelToStore returns a store based on the given model object. It maps all properties from model to store.
+func modelToStore(m *projectmodel.Project) *projectstore.Project {
+	return &projectstore.Project{
+		ID:         m.ID,
+		Name:       m.Name,
+		Customer:   m.Customer,
+		CreatedAt:  m.CreatedAt,
+		ModifiedAt: m.ModifiedAt,
+	}
+}
@@ -0,0 +1,175 @@
+package projectmapper_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rebel-l/go-utils/osutils"
+	"github.com/rebel-l/go-utils/testingutils"
+	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/config"
+	"github.com/rebel-l/ttrack_api/project/projectmapper"
+	"github.com/rebel-l/ttrack_api/project/projectmodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func setup(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+
+	// 0. init path
+	storagePath := filepath.Join(".", "..", "..", "storage", "test_project", name)
+	scriptPath := filepath.Join(".", "..", "..", "scripts", "sql", "sqlite")
+	conf := &config.Database{
+		StoragePath:       &storagePath,
+		SchemaScriptsPath: &scriptPath,
+	}
+
+	// 1. clean up
+	if osutils.FileOrPathExists(conf.GetStoragePath()) {
+		if err := os.RemoveAll(conf.GetStoragePath()); err != nil {
+			t.Fatalf("failed to cleanup test files: %v", err)
+		}
+	}
+
+	// 2. init database
+	db, err := bootstrap.Database(conf, "0.0.0", false)
+	if err != nil {
+		t.Fatalf("No error expected: %v", err)
+	}
+
+	return db
+}
+
+func TestMapper_SaveLoad(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperSaveLoad")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := projectmapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+
+	// 2. test
+	if _, err := mapper.Save(ctx, nil); !errors.Is(err, projectmapper.ErrNoData) {
+		t.Errorf("expected error '%v' but got '%v'", projectmapper.ErrNoData, err)
+	}
+
+	if _, err := mapper.Load(ctx, testingutils.UUIDParse(t, "5f0d3c1e-7b1a-4c8e-9d2f-6a5b4c3d2e1f")); !errors.Is(err, projectmapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", projectmapper.ErrNotFound, err)
+	}
+
+	saved, err := mapper.Save(ctx, &projectmodel.Project{Name: "Relaunch", Customer: "ACME"})
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	saved.Name = "Relaunch 2"
+
+	updated, err := mapper.Save(ctx, saved)
+	if err != nil {
+		t.Fatalf("expected no error on update but got '%v'", err)
+	}
+
+	loaded, err := mapper.Load(ctx, saved.ID)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if loaded.ID != updated.ID || loaded.Name != "Relaunch 2" || loaded.Customer != "ACME" {
+		t.Errorf("expected project '%v' but got '%v'", updated, loaded)
+	}
+
+	all, err := mapper.LoadAll(ctx)
+	if err != nil {
+		t.Fatalf("expected no error on load all but got '%v'", err)
+	}
+
+	if len(all) != 1 {
+		t.Errorf("expected 1 project but got %d", len(all))
+	}
+}
+
+func TestMapper_Delete(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperDelete")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := projectmapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+
+	project, err := mapper.Save(ctx, &projectmodel.Project{Name: "Relaunch"})
+	if err != nil {
+		t.Fatalf("failed to prepare project: %v", err)
+	}
+
+	stop := time.Date(2024, 6, 3, 17, 0, 0, 0, time.UTC)
+	tMapper := timelogmapper.New(db)
+
+	timelog, err := tMapper.Save(ctx, &timelogmodel.Timelog{
+		Start:     time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC),
+		Stop:      &stop,
+		Reason:    timelogmodel.ReasonWork,
+		Location:  timelogmodel.LocationHome,
+		ProjectID: &project.ID,
+	})
+	if err != nil {
+		t.Fatalf("failed to prepare timelog: %v", err)
+	}
+
+	// 2. test
+	if err := mapper.Delete(ctx, project.ID); err != nil {
+		t.Fatalf("expected no error on delete but got '%v'", err)
+	}
+
+	if _, err := mapper.Load(ctx, project.ID); !errors.Is(err, projectmapper.ErrNotFound) {
+		t.Errorf("expected that project was deleted but got error '%v'", err)
+	}
+
+	timelog, err = tMapper.Load(ctx, timelog.ID)
+	if err != nil {
+		t.Fatalf("expected timelog to be kept but got error '%v'", err)
+	}
+
+	if timelog.ProjectID != nil {
+		t.Errorf("expected project of timelog to be removed but got %s", timelog.ProjectID)
+	}
+
+	_, err = tMapper.Save(ctx, &timelogmodel.Timelog{
+		Start:     time.Date(2024, 6, 4, 8, 0, 0, 0, time.UTC),
+		Reason:    timelogmodel.ReasonWork,
+		Location:  timelogmodel.LocationHome,
+		ProjectID: &project.ID,
+	})
+	if !errors.Is(err, timelogmapper.ErrProjectNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", timelogmapper.ErrProjectNotFound, err)
+	}
+}
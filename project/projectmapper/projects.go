@@ -0,0 +1,25 @@
+package projectmapper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rebel-l/ttrack_api/project/projectmodel"
+	"github.com/rebel-l/ttrack_api/project/projectstore"
+)
+
+// LoadAll returns all projects ordered by name.
+func (m *Mapper) LoadAll(ctx context.Context) (projectmodel.Projects, error) {
+	s := &projectstore.Projects{}
+
+	if err := s.Load(ctx, m.db, ""); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	models := projectmodel.Projects{}
+	for _, v := range *s {
+		models = append(models, StoreToModel(v))
+	}
+
+	return models, nil
+}
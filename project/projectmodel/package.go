@@ -0,0 +1,2 @@
+// Package projectmodel provides functionality and business logic to manage projects.
+package projectmodel
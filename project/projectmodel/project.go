@@ -0,0 +1,71 @@
+package projectmodel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxLengthName defines the maximum number of characters of the project name.
+	MaxLengthName = 100
+
+	// MaxLengthCustomer defines the maximum number of characters of the customer name.
+	MaxLengthCustomer = 100
+)
+
+var (
+	// ErrDecodeJSON occurs if a string is not in JSON format.
+	ErrDecodeJSON = errors.New("failed to decode JSON")
+
+	// ErrValidationNameMandatory occurs during validation if the name wasn't set.
+	ErrValidationNameMandatory = errors.New("name should not be empty")
+
+	// ErrValidationTooLong occurs during validation if a value exceeds its maximum length.
+	ErrValidationTooLong = errors.New("value is too long")
+)
+
+// Project represents a model of repository including business logic.
+type Project struct {
+	ID         uuid.UUID `json:"ID"`
+	Name       string    `json:"Name"`
+	Customer   string    `json:"Customer"`
+	CreatedAt  time.Time `json:"CreatedAt"`
+	ModifiedAt time.Time `json:"ModifiedAt"`
+}
+
+// DecodeJSON converts JSON data to struct.
+func (p *Project) DecodeJSON(reader io.Reader) error {
+	if p == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(reader)
+	if err := decoder.Decode(p); err != nil {
+		return fmt.Errorf("%w: %v", ErrDecodeJSON, err)
+	}
+
+	return nil
+}
+
+// Validate is validating the attributes of the struct to valid values. If the validation fails it returns the reason
+// why it failed in the error message.
+func (p *Project) Validate() error {
+	if p.Name == "" {
+		return ErrValidationNameMandatory
+	}
+
+	if len([]rune(p.Name)) > MaxLengthName {
+		return fmt.Errorf("%w: name has more than %d characters", ErrValidationTooLong, MaxLengthName)
+	}
+
+	if len([]rune(p.Customer)) > MaxLengthCustomer {
+		return fmt.Errorf("%w: customer has more than %d characters", ErrValidationTooLong, MaxLengthCustomer)
+	}
+
+	return nil
+}
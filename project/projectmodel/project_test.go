@@ -0,0 +1,162 @@
+package projectmodel_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/go-utils/testingutils"
+	"github.com/rebel-l/ttrack_api/project/projectmodel"
+)
+
+func TestProject_DecodeJSON(t *testing.T) {
+	t.Parallel()
+
+	createdAt, _ := time.Parse(time.RFC3339Nano, "2019-12-31T03:36:57.9167778+01:00")
+	modifiedAt, _ := time.Parse(time.RFC3339Nano, "2020-01-01T15:44:57.9168378+01:00")
+
+	testCases := []struct {
+		name        string
+		actual      *projectmodel.Project
+		json        io.Reader
+		expected    *projectmodel.Project
+		expectedErr error
+	}{
+		{
+			name: "model is nil",
+		},
+		{
+			name:        "no JSON format",
+			actual:      &projectmodel.Project{},
+			json:        bytes.NewReader([]byte("no JSON")),
+			expected:    &projectmodel.Project{},
+			expectedErr: projectmodel.ErrDecodeJSON,
+		},
+		{
+			name:   "success",
+			actual: &projectmodel.Project{},
+			json: bytes.NewReader([]byte(`
+                {
+    "ID": "0d6d9a58-6bb4-4a4c-a1b1-7f1e8b6c2e54",
+    "Name": "Relaunch",
+    "Customer": "ACME",
+    "CreatedAt": "2019-12-31T03:36:57.9167778+01:00",
+    "ModifiedAt": "2020-01-01T15:44:57.9168378+01:00"
+}
+            `)),
+			expected: &projectmodel.Project{
+				ID:         testingutils.UUIDParse(t, "0d6d9a58-6bb4-4a4c-a1b1-7f1e8b6c2e54"),
+				Name:       "Relaunch",
+				Customer:   "ACME",
+				CreatedAt:  createdAt,
+				ModifiedAt: modifiedAt,
+			},
+		},
+		{
+			name:     "empty json",
+			actual:   &projectmodel.Project{},
+			json:     bytes.NewReader([]byte("{}")),
+			expected: &projectmodel.Project{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.actual.DecodeJSON(testCase.json)
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+
+				return
+			}
+
+			assertProject(t, testCase.expected, testCase.actual)
+		})
+	}
+}
+
+func TestProject_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		actual      *projectmodel.Project
+		expectedErr error
+	}{
+		{
+			name:        "name is empty",
+			actual:      &projectmodel.Project{Customer: "ACME"},
+			expectedErr: projectmodel.ErrValidationNameMandatory,
+		},
+		{
+			name:        "name is too long",
+			actual:      &projectmodel.Project{Name: strings.Repeat("n", projectmodel.MaxLengthName+1)},
+			expectedErr: projectmodel.ErrValidationTooLong,
+		},
+		{
+			name: "customer is too long",
+			actual: &projectmodel.Project{
+				Name:     "Relaunch",
+				Customer: strings.Repeat("c", projectmodel.MaxLengthCustomer+1),
+			},
+			expectedErr: projectmodel.ErrValidationTooLong,
+		},
+		{
+			name:   "name only",
+			actual: &projectmodel.Project{Name: "Relaunch"},
+		},
+		{
+			name:   "name and customer",
+			actual: &projectmodel.Project{Name: "Relaunch", Customer: "ACME"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.actual.Validate()
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func assertProject(t *testing.T, expected, actual *projectmodel.Project) {
+	t.Helper()
+
+	if expected == nil && actual == nil {
+		return
+	}
+
+	if expected != nil && actual == nil || expected == nil && actual != nil {
+		t.Errorf("expected '%v' but got '%v'", expected, actual)
+
+		return
+	}
+
+	if expected.ID != actual.ID {
+		t.Errorf("expected ID %s but got %s", expected.ID, actual.ID)
+	}
+
+	if expected.Name != actual.Name {
+		t.Errorf("expected Name %s but got %s", expected.Name, actual.Name)
+	}
+
+	if expected.Customer != actual.Customer {
+		t.Errorf("expected Customer %s but got %s", expected.Customer, actual.Customer)
+	}
+
+	if !expected.CreatedAt.Equal(actual.CreatedAt) {
+		t.Errorf("expected created at '%s' but got '%s'", expected.CreatedAt.String(), actual.CreatedAt.String())
+	}
+
+	if !expected.ModifiedAt.Equal(actual.ModifiedAt) {
+		t.Errorf("expected modified at '%s' but got '%s'", expected.ModifiedAt.String(), actual.ModifiedAt.String())
+	}
+}
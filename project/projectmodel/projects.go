@@ -0,0 +1,3 @@
+package projectmodel
+
+type Projects []*Project
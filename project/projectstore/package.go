@@ -0,0 +1,2 @@
+// Package projectstore contains the CRUD operations for the projects on the database.
+package projectstore
@@ -0,0 +1,140 @@
+package projectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+)
+
+const (
+	qSelect = `
+		SELECT id, name, customer, created_at, modified_at
+        FROM projects
+	`
+)
+
+var (
+	// ErrIDMissing will be thrown if an ID is expected but not set.
+	ErrIDMissing = errors.New("id is mandatory for this operation")
+
+	// ErrCreatingID will be thrown if creating an ID failed.
+	ErrCreatingID = errors.New("id creation failed")
+
+	// ErrIDIsSet will be thrown if no ID is expected but already set.
+	ErrIDIsSet = errors.New("id should be not set for this operation, use update instead")
+
+	// ErrDataMissing will be thrown if mandatory data is not set.
+	ErrDataMissing = errors.New("no data or mandatory data missing")
+)
+
+// Project represents the project in the database.
+type Project struct {
+	ID         uuid.UUID `db:"id"`
+	Name       string    `db:"name"`
+	Customer   string    `db:"customer"`
+	CreatedAt  time.Time `db:"created_at"`
+	ModifiedAt time.Time `db:"modified_at"`
+}
+
+// Create creates current object in the database.
+func (p *Project) Create(ctx context.Context, db sqlx.ExtContext) error {
+	if !p.IsValid() {
+		return ErrDataMissing
+	}
+
+	if !uuidutils.IsEmpty(p.ID) {
+		return ErrIDIsSet
+	}
+
+	var err error
+
+	p.ID, err = uuid.NewRandom()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCreatingID, err)
+	}
+
+	q := db.Rebind(`
+		INSERT INTO projects (id, name, customer) 
+		VALUES (?, ?, ?);
+	`)
+
+	_, err = db.ExecContext(ctx, q, p.ID, p.Name, p.Customer)
+	if err != nil {
+		return fmt.Errorf("failed to create: %w", err)
+	}
+
+	return p.Read(ctx, db)
+}
+
+// Read sets the project from database by given ID.
+func (p *Project) Read(ctx context.Context, db sqlx.ExtContext) error {
+	if p == nil || uuidutils.IsEmpty(p.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE id = ?;
+    `)
+	if err := sqlx.GetContext(ctx, db, p, q, p.ID); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// Update changes the current object on the database by ID.
+func (p *Project) Update(ctx context.Context, db sqlx.ExtContext) error {
+	if !p.IsValid() {
+		return ErrDataMissing
+	}
+
+	if uuidutils.IsEmpty(p.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+		UPDATE projects 
+		SET name = ?, customer = ? 
+		WHERE id = ?;
+	`)
+
+	_, err := db.ExecContext(ctx, q, p.Name, p.Customer, p.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update: %w", err)
+	}
+
+	return p.Read(ctx, db)
+}
+
+// Delete removes the current object from database by its ID.
+func (p *Project) Delete(ctx context.Context, db sqlx.ExtContext) error {
+	if p == nil || uuidutils.IsEmpty(p.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+        DELETE FROM projects
+        WHERE id = ?
+    `)
+
+	if _, err := db.ExecContext(ctx, q, p.ID); err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	return nil
+}
+
+// IsValid returns true if all mandatory fields are set.
+func (p *Project) IsValid() bool {
+	if p == nil || p.Name == "" {
+		return false
+	}
+
+	return true
+}
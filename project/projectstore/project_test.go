@@ -0,0 +1,302 @@
+package projectstore_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rebel-l/go-utils/osutils"
+	"github.com/rebel-l/go-utils/testingutils"
+	"github.com/rebel-l/go-utils/uuidutils"
+	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/config"
+	"github.com/rebel-l/ttrack_api/project/projectstore"
+)
+
+func setup(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+
+	// 0. init path
+	storagePath := filepath.Join(".", "..", "..", "storage", "test_project", name)
+	scriptPath := filepath.Join(".", "..", "..", "scripts", "sql", "sqlite")
+	conf := &config.Database{
+		StoragePath:       &storagePath,
+		SchemaScriptsPath: &scriptPath,
+	}
+
+	// 1. clean up
+	if osutils.FileOrPathExists(conf.GetStoragePath()) {
+		if err := os.RemoveAll(conf.GetStoragePath()); err != nil {
+			t.Fatalf("failed to cleanup test files: %v", err)
+		}
+	}
+
+	// 2. init database
+	db, err := bootstrap.Database(conf, "0.0.0", false)
+	if err != nil {
+		t.Fatalf("No error expected: %v", err)
+	}
+
+	return db
+}
+
+func TestProject_Create(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "storeCreate")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	// 2. test
+	testCases := []struct {
+		name        string
+		actual      *projectstore.Project
+		expected    *projectstore.Project
+		expectedErr error
+	}{
+		{
+			name:        "project is nil",
+			expectedErr: projectstore.ErrDataMissing,
+		},
+		{
+			name: "project has customer only",
+			actual: &projectstore.Project{
+				Customer: "ACME",
+			},
+			expectedErr: projectstore.ErrDataMissing,
+		},
+		{
+			name: "project has id",
+			actual: &projectstore.Project{
+				ID:   testingutils.UUIDParse(t, "3a0b8f5e-2e2e-4a8c-9a8e-7d0c2f3b1a11"),
+				Name: "Relaunch",
+			},
+			expectedErr: projectstore.ErrIDIsSet,
+		},
+		{
+			name: "project has all fields set",
+			actual: &projectstore.Project{
+				Name:     "Relaunch",
+				Customer: "ACME",
+			},
+			expected: &projectstore.Project{
+				Name:     "Relaunch",
+				Customer: "ACME",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.actual.Create(context.Background(), db)
+			testingutils.ErrorsCheck(t, testCase.expectedErr, err)
+
+			if testCase.expected != nil {
+				testCase.expected.ID = testCase.actual.ID
+				assertProject(t, testCase.expected, testCase.actual)
+			}
+		})
+	}
+}
+
+func TestProject_Update(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "storeUpdate")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	// 2. test
+	testCases := []struct {
+		name        string
+		prepare     *projectstore.Project
+		actual      *projectstore.Project
+		expected    *projectstore.Project
+		expectedErr error
+	}{
+		{
+			name:        "project is nil",
+			expectedErr: projectstore.ErrDataMissing,
+		},
+		{
+			name: "project has no id",
+			actual: &projectstore.Project{
+				Name: "Relaunch",
+			},
+			expectedErr: projectstore.ErrIDMissing,
+		},
+		{
+			name: "not existing",
+			actual: &projectstore.Project{
+				ID:   testingutils.UUIDParse(t, "9a6b4a1c-5a4f-44a4-8a0d-1b8c1b7c7e42"),
+				Name: "Relaunch",
+			},
+			expectedErr: sql.ErrNoRows,
+		},
+		{
+			name: "success",
+			prepare: &projectstore.Project{
+				Name:     "Relaunch",
+				Customer: "ACME",
+			},
+			actual: &projectstore.Project{
+				Name:     "Migration",
+				Customer: "Globex",
+			},
+			expected: &projectstore.Project{
+				Name:     "Migration",
+				Customer: "Globex",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if testCase.prepare != nil {
+				if err := testCase.prepare.Create(context.Background(), db); err != nil {
+					t.Fatalf("preparation failed: %v", err)
+				}
+
+				testCase.actual.ID = testCase.prepare.ID
+			}
+
+			err := testCase.actual.Update(context.Background(), db)
+			testingutils.ErrorsCheck(t, testCase.expectedErr, err)
+
+			if testCase.expected != nil {
+				testCase.expected.ID = testCase.actual.ID
+				assertProject(t, testCase.expected, testCase.actual)
+			}
+		})
+	}
+}
+
+func TestProject_Delete(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "storeDelete")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	// 2. test
+	testCases := []struct {
+		name        string
+		prepare     *projectstore.Project
+		expectedErr error
+	}{
+		{
+			name:        "project has no ID",
+			expectedErr: projectstore.ErrIDMissing,
+		},
+		{
+			name: "success",
+			prepare: &projectstore.Project{
+				Name: "Relaunch",
+			},
+		},
+		{
+			name: "not existing",
+			prepare: &projectstore.Project{
+				ID: testingutils.UUIDParse(t, "c1e2a8a3-3f1b-4a9a-9f5e-0e1a2b3c4d5e"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var id uuid.UUID
+			if testCase.prepare != nil {
+				if testCase.prepare.IsValid() {
+					if err := testCase.prepare.Create(context.Background(), db); err != nil {
+						t.Fatalf("preparation failed: %v", err)
+					}
+				}
+				id = testCase.prepare.ID
+			}
+
+			actual := &projectstore.Project{ID: id}
+			err := actual.Delete(context.Background(), db)
+			testingutils.ErrorsCheck(t, testCase.expectedErr, err)
+
+			if !uuidutils.IsEmpty(id) {
+				err := actual.Read(context.Background(), db)
+				if !errors.Is(err, sql.ErrNoRows) {
+					t.Errorf("expected error '%v' after deletion but got '%v'", sql.ErrNoRows, err)
+				}
+			}
+		})
+	}
+}
+
+func assertProject(t *testing.T, expected, actual *projectstore.Project) {
+	t.Helper()
+
+	if expected == nil && actual == nil {
+		return
+	}
+
+	if expected != nil && actual == nil || expected == nil && actual != nil {
+		t.Errorf("expected '%v' but got '%v'", expected, actual)
+
+		return
+	}
+
+	if expected.ID != actual.ID {
+		t.Errorf("expected ID %s but got %s", expected.ID, actual.ID)
+	}
+
+	if expected.Name != actual.Name {
+		t.Errorf("expected Name %s but got %s", expected.Name, actual.Name)
+	}
+
+	if expected.Customer != actual.Customer {
+		t.Errorf("expected Customer %s but got %s", expected.Customer, actual.Customer)
+	}
+
+	if actual.CreatedAt.IsZero() {
+		t.Error("created at should be greater than the zero date")
+	}
+
+	if actual.ModifiedAt.IsZero() {
+		t.Error("modified at should be greater than the zero date")
+	}
+}
@@ -0,0 +1,27 @@
+package projectstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Projects []*Project
+
+func (p *Projects) Load(ctx context.Context, db sqlx.ExtContext, where string, args ...any) error {
+	q := qSelect
+	if where != "" {
+		q += " WHERE " + where
+	}
+	q += " ORDER BY name "
+
+	if err := sqlx.SelectContext(ctx, db, p, db.Rebind(q), args...); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	return nil
+}
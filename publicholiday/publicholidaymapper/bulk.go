@@ -0,0 +1,119 @@
+package publicholidaymapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+)
+
+// ErrBulkSave occurs if at least one public holiday of a bulk save was rejected.
+var ErrBulkSave = errors.New("failed to save public holidays")
+
+// ItemError describes why a public holiday of a bulk save was rejected. Index is the position within the batch.
+type ItemError struct {
+	Index int    `json:"Index"`
+	Day   string `json:"Day"`
+	Error string `json:"Error"`
+	err   error
+}
+
+// BulkError provides the reasons for all public holidays rejected by SaveAll.
+type BulkError struct {
+	Items []ItemError
+}
+
+// Error returns the error message including the reasons of all rejected public holidays.
+func (e *BulkError) Error() string {
+	items := make([]string, 0, len(e.Items))
+	for _, v := range e.Items {
+		items = append(items, fmt.Sprintf("#%d: %v", v.Index, v.err))
+	}
+
+	return fmt.Sprintf("%s: %s", ErrBulkSave, strings.Join(items, "; "))
+}
+
+// Unwrap makes the BulkError comparable with ErrBulkSave.
+func (e *BulkError) Unwrap() error {
+	return ErrBulkSave
+}
+
+// Conflict returns true if at least one public holiday was rejected because its day is already taken.
+func (e *BulkError) Conflict() bool {
+	for _, v := range e.Items {
+		if errors.Is(v.err, ErrDuplicateDay) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SaveAll validates and persists (create or update) all models within one transaction. If any of them fails, nothing
+// is persisted and a BulkError listing every rejected model is returned. On success the saved models are returned in
+// the order given.
+func (m *Mapper) SaveAll(
+	ctx context.Context,
+	models publicholidaymodel.PublicHolidays,
+) (publicholidaymodel.PublicHolidays, error) {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	saved := make(publicholidaymodel.PublicHolidays, 0, len(models))
+	bulkErr := &BulkError{}
+
+	for i, model := range models {
+		if model == nil {
+			bulkErr.Items = append(bulkErr.Items, newItemError(i, model, ErrNoData))
+
+			continue
+		}
+
+		if err := model.Validate(); err != nil {
+			bulkErr.Items = append(bulkErr.Items, newItemError(i, model, err))
+
+			continue
+		}
+
+		s, err := save(ctx, tx, model)
+		if err != nil {
+			bulkErr.Items = append(bulkErr.Items, newItemError(i, model, err))
+
+			continue
+		}
+
+		saved = append(saved, s)
+	}
+
+	if len(bulkErr.Items) > 0 {
+		return nil, bulkErr
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	return saved, nil
+}
+
+// newItemError returns the ItemError for the model at the given index. Details of database errors are not exposed.
+func newItemError(index int, model *publicholidaymodel.PublicHoliday, err error) ItemError {
+	itemErr := ItemError{Index: index, Error: err.Error(), err: err}
+
+	if model != nil && !model.Day.IsZero() {
+		itemErr.Day = model.Day.Format(time.DateOnly)
+	}
+
+	if errors.Is(err, ErrSaveToDB) {
+		itemErr.Error = ErrSaveToDB.Error()
+	}
+
+	return itemErr
+}
@@ -0,0 +1,113 @@
+package publicholidaymapper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/go-utils/testingutils"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmodel"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymapper"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+)
+
+func TestMapper_SaveAll(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperSaveAll")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	existing, err := prepareData(db, &publicholidaymodel.PublicHoliday{
+		Day:  time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC),
+		Name: "1. Weihnachtsfeiertag",
+	})
+	if err != nil {
+		t.Fatalf("failed to prepare data: %v", err)
+	}
+
+	mapper := publicholidaymapper.New(db)
+	ctx := context.Background()
+	unknownCalendar := testingutils.UUIDParse(t, "5f0d3c1e-7b1a-4c8e-9d2f-6a5b4c3d2e1f")
+
+	// 2. test
+	_, err = mapper.SaveAll(ctx, publicholidaymodel.PublicHolidays{
+		{Day: time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC), Name: "Heiligabend", HalfDay: true},
+		{Day: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC), Name: "Christmas"},
+		{Day: time.Date(2024, 12, 26, 12, 0, 0, 0, time.UTC), Name: "2. Weihnachtsfeiertag"},
+		{CalendarID: unknownCalendar, Day: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), Name: "Silvester"},
+		nil,
+	})
+
+	var bulkErr *publicholidaymapper.BulkError
+	if !errors.As(err, &bulkErr) {
+		t.Fatalf("expected error '%v' but got '%v'", publicholidaymapper.ErrBulkSave, err)
+	}
+
+	if !bulkErr.Conflict() {
+		t.Error("expected the bulk error to be a conflict")
+	}
+
+	expectedItems := []struct {
+		index int
+		day   string
+		err   error
+	}{
+		{index: 1, day: "2024-12-25", err: publicholidaymapper.ErrDuplicateDay},
+		{index: 2, day: "2024-12-26", err: publicholidaymodel.ErrValidationDayNoDate},
+		{index: 3, day: "2024-12-31", err: publicholidaymapper.ErrCalendarNotFound},
+		{index: 4, err: publicholidaymapper.ErrNoData},
+	}
+
+	if len(bulkErr.Items) != len(expectedItems) {
+		t.Fatalf("expected %d item errors but got %d: %v", len(expectedItems), len(bulkErr.Items), bulkErr.Items)
+	}
+
+	for i, v := range expectedItems {
+		if bulkErr.Items[i].Index != v.index || bulkErr.Items[i].Day != v.day {
+			t.Errorf("expected item error for %d on '%s' but got %d on '%s'",
+				v.index, v.day, bulkErr.Items[i].Index, bulkErr.Items[i].Day)
+		}
+	}
+
+	loaded, err := mapper.LoadByYear(ctx, calendarmodel.DefaultID, 2024)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if len(loaded) != 1 {
+		t.Fatalf("expected the bulk save to be rolled back but got %d public holidays", len(loaded))
+	}
+
+	assertPublicHoliday(t, existing, loaded[0])
+
+	existing.Name = "Christmas"
+
+	saved, err := mapper.SaveAll(ctx, publicholidaymodel.PublicHolidays{
+		{Day: time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC), Name: "Heiligabend", HalfDay: true},
+		existing,
+		{Day: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Name: "Neujahr"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	byYear := saved.ByYear()
+	if len(byYear[2024]) != 2 || len(byYear[2025]) != 1 {
+		t.Errorf("expected 2 public holidays in 2024 and 1 in 2025 but got %v", byYear)
+	}
+
+	if saved[1].ID != existing.ID || saved[1].Name != "Christmas" {
+		t.Errorf("expected existing public holiday to be updated but got %v", saved[1])
+	}
+}
@@ -5,10 +5,13 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/rebel-l/go-utils/uuidutils"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmodel"
+	"github.com/rebel-l/ttrack_api/calendar/calendarstore"
 	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
 	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaystore"
 )
@@ -29,6 +32,12 @@ var (
 	// ErrNotFound occurs if record doesn't exist in database.
 	ErrNotFound = errors.New("publicholiday was not found")
 
+	// ErrDuplicateDay occurs if another public holiday of the same calendar exists on the same day.
+	ErrDuplicateDay = errors.New("there is already a public holiday on this day")
+
+	// ErrCalendarNotFound occurs if the public holiday references a calendar which doesn't exist.
+	ErrCalendarNotFound = errors.New("calendar of public holiday was not found")
+
 	// ErrConvert occurs if data type conversion failed.
 	ErrConvert = errors.New("conversion error")
 )
@@ -56,33 +65,82 @@ func (m *Mapper) Load(ctx context.Context, id uuid.UUID) (*publicholidaymodel.Pu
 	return StoreToModel(s), nil
 }
 
-// Save persists (create or update) the model and returns the changed data (id, createdAt or modifiedAt).
+// Save persists (create or update) the model and returns the changed data (id, createdAt or modifiedAt). It fails
+// with ErrCalendarNotFound if the calendar doesn't exist and with ErrDuplicateDay if there is another public holiday
+// of the same calendar on the same day. The checks and the saving are done in one transaction.
 func (m *Mapper) Save(ctx context.Context, model *publicholidaymodel.PublicHoliday) (*publicholidaymodel.PublicHoliday, error) {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	model, err = save(ctx, tx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	return model, nil
+}
+
+func save(
+	ctx context.Context,
+	db sqlx.ExtContext,
+	model *publicholidaymodel.PublicHoliday,
+) (*publicholidaymodel.PublicHoliday, error) {
 	if model == nil {
 		return nil, ErrNoData
 	}
 
 	s := modelToStore(model)
 
+	c := &calendarstore.Calendar{ID: s.CalendarID} // nolint: exhaustivestruct
+	if err := c.Read(ctx, db); errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrCalendarNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	existing := &publicholidaystore.PublicHolidays{}
+	if err := existing.Load(
+		ctx,
+		db,
+		"calendar_id = ? AND substr(day, 1, 10) = ? AND id != ?",
+		s.CalendarID,
+		s.Day.Format(time.DateOnly),
+		s.ID,
+	); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	if len(*existing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrDuplicateDay, s.Day.Format(time.DateOnly))
+	}
+
 	if uuidutils.IsEmpty(model.ID) {
-		if err := s.Create(ctx, m.db); err != nil {
+		if err := s.Create(ctx, db); err != nil {
 			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
 		}
 	} else {
-		if err := s.Update(ctx, m.db); err != nil {
+		if err := s.Update(ctx, db); err != nil {
 			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
 		}
 	}
 
-	model = StoreToModel(s)
-
-	return model, nil
+	return StoreToModel(s), nil
 }
 
 // Delete removes a model from database by ID.
 func (m *Mapper) Delete(ctx context.Context, id uuid.UUID) error {
 	s := &publicholidaystore.PublicHoliday{ID: id} // nolint: exhaustivestruct
-	if err := s.Delete(ctx, m.db); err != nil {
+	if err := s.Delete(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	} else if err != nil {
 		return fmt.Errorf("%w: %v", ErrDeleteFromDB, err)
 	}
 
@@ -97,6 +155,7 @@ func StoreToModel(s *publicholidaystore.PublicHoliday) *publicholidaymodel.Publi
 
 	return &publicholidaymodel.PublicHoliday{
 		ID:         s.ID,
+		CalendarID: s.CalendarID,
 		Day:        s.Day,
 		Name:       s.Name,
 		HalfDay:    s.HalfDay,
@@ -106,9 +165,16 @@ func StoreToModel(s *publicholidaystore.PublicHoliday) *publicholidaymodel.Publi
 }
 
 // modelToStore returns a store based on the given model object. It maps all properties from model to store.
+// If the model has no calendar, it is assigned to the default calendar.
 func modelToStore(m *publicholidaymodel.PublicHoliday) *publicholidaystore.PublicHoliday {
+	calendarID := m.CalendarID
+	if uuidutils.IsEmpty(calendarID) {
+		calendarID = calendarmodel.DefaultID
+	}
+
 	return &publicholidaystore.PublicHoliday{
 		ID:         m.ID,
+		CalendarID: calendarID,
 		Day:        m.Day,
 		Name:       m.Name,
 		HalfDay:    m.HalfDay,
@@ -8,7 +8,9 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/rebel-l/go-utils/osutils"
 	"github.com/rebel-l/go-utils/testingutils"
+	"github.com/rebel-l/go-utils/uuidutils"
 	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmodel"
 	"github.com/rebel-l/ttrack_api/config"
 	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymapper"
 	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
@@ -56,20 +58,24 @@ func prepareData(db *sqlx.DB, p *publicholidaymodel.PublicHoliday) (*publicholid
 		return nil, fmt.Errorf("failed to generate id: %w", err)
 	}
 
+	if uuidutils.IsEmpty(p.CalendarID) {
+		p.CalendarID = calendarmodel.DefaultID
+	}
+
 	ctx := context.Background()
 	q := db.Rebind(`
-		INSERT INTO publicholidays (id, day, name, halfday) 
-		VALUES (?, ?, ?, ?);
+		INSERT INTO publicholidays (id, calendar_id, day, name, halfday) 
+		VALUES (?, ?, ?, ?, ?);
 	`)
 
-	_, err = db.ExecContext(ctx, q, p.ID, p.Day, p.Name, p.HalfDay)
+	_, err = db.ExecContext(ctx, q, p.ID, p.CalendarID, p.Day, p.Name, p.HalfDay)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create data: %w", err)
 	}
 
 	ps := &publicholidaystore.PublicHoliday{}
 
-	q = db.Rebind(`SELECT id, day, name, halfday, created_at, modified_at FROM publicholidays WHERE id = ?`)
+	q = db.Rebind(`SELECT id, calendar_id, day, name, halfday, created_at, modified_at FROM publicholidays WHERE id = ?`)
 
 	if err := db.GetContext(ctx, ps, q, p.ID); err != nil {
 		return nil, fmt.Errorf("failed to retrieve created data: %w", err)
@@ -202,7 +208,7 @@ func TestMapper_Save(t *testing.T) {
 		{
 			name: "model has ID",
 			prepare: &publicholidaymodel.PublicHoliday{
-				Day:     now,
+				Day:     now.Add(48 * time.Hour),
 				Name:    "80WuukKQxbasVEL8wu7VJBQ8Ok91NnLntpjpdNuuReephgxFpkMY23aV1vB1iac0tlIwbbhWEor1NhthphLx6bqKbT2PeCiYc8FjLpyPXBoDhfbnWA2Eey9IoY4CNfckQVbbdUmU5lSbRoD9cE4cL0YtYGSm",
 				HalfDay: true,
 			},
@@ -221,11 +227,24 @@ func TestMapper_Save(t *testing.T) {
 			name: "update not existing model",
 			actual: &publicholidaymodel.PublicHoliday{
 				ID:      testingutils.UUIDParse(t, "63f3dc0b-54bf-4c5d-b577-5c20e047bd10"),
-				Day:     now,
+				Day:     now.Add(72 * time.Hour),
 				Name:    "r8VzAcQEBwb7wxs0To47hUggccbjtKoyIb89nAYOj7CMDr7J6BBF99KdjosCUV0YSzqkQl7eYt7hZoJNAUfX1hOV3v1zbpbqvIqGj5q6XGmx25vzGdllXkEcswtsJkXvulLVOly0U2odyl9LKVoYeFhGL5IP3zm0J6htYL6LYKZwNnn",
 				HalfDay: true,
 			},
 			expectedErr: publicholidaymapper.ErrSaveToDB,
+		},
+		{
+			name: "other model on same day",
+			prepare: &publicholidaymodel.PublicHoliday{
+				Day:  now.Add(96 * time.Hour),
+				Name: "Tag der Arbeit",
+			},
+			actual: &publicholidaymodel.PublicHoliday{
+				Day:  now.Add(96 * time.Hour),
+				Name: "Maifeiertag",
+			},
+			expectedErr: publicholidaymapper.ErrDuplicateDay,
+			duplicate:   true,
 		}}
 
 	for _, testCase := range testCases {
@@ -296,8 +315,9 @@ func TestMapper_Delete(t *testing.T) {
 			},
 		},
 		{
-			name: "publicholiday not existing",
-			id:   testingutils.UUIDParse(t, "f2b3fa9b-4d63-49a6-9dc9-9a4fd3d10fe6"),
+			name:        "publicholiday not existing",
+			id:          testingutils.UUIDParse(t, "f2b3fa9b-4d63-49a6-9dc9-9a4fd3d10fe6"),
+			expectedErr: publicholidaymapper.ErrNotFound,
 		},
 	}
 
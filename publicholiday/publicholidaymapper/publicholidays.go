@@ -5,16 +5,22 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmodel"
 	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
 
 	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
 	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaystore"
 )
 
-func (m *Mapper) LoadAll(ctx context.Context) (publicholidaymodel.PublicHolidaysByYear, error) {
+// LoadAll returns all public holidays of the given calendar grouped by year. Years having timelogs and the next year
+// are always contained, even if they have no public holidays yet.
+func (m *Mapper) LoadAll(ctx context.Context, calendarID uuid.UUID) (publicholidaymodel.PublicHolidaysByYear, error) {
 	s := &publicholidaystore.PublicHolidays{}
 
-	if err := s.Load(ctx, m.db, ""); err != nil {
+	if err := s.Load(ctx, m.db, "calendar_id = ?", calendarID); err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
 	}
 
@@ -52,10 +58,45 @@ func (m *Mapper) LoadAll(ctx context.Context) (publicholidaymodel.PublicHolidays
 	return models, nil
 }
 
-func (m *Mapper) LoadByYear(ctx context.Context, year int) (publicholidaymodel.PublicHolidays, error) {
+// LoadByYear returns the public holidays of the given calendar and year.
+func (m *Mapper) LoadByYear(
+	ctx context.Context,
+	calendarID uuid.UUID,
+	year int,
+) (publicholidaymodel.PublicHolidays, error) {
+	return m.LoadByPeriod(
+		ctx,
+		calendarID,
+		time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC),
+	)
+}
+
+// LoadByPeriod returns the public holidays of the given calendar between firstDay and lastDay, both included. The time
+// of firstDay and lastDay is ignored.
+func (m *Mapper) LoadByPeriod(
+	ctx context.Context,
+	calendarID uuid.UUID,
+	firstDay, lastDay time.Time,
+) (publicholidaymodel.PublicHolidays, error) {
+	return loadByPeriod(ctx, m.db, calendarID, firstDay, lastDay)
+}
+
+// loadByPeriod returns the public holidays of the given calendar between firstDay and lastDay, both included.
+func loadByPeriod(
+	ctx context.Context,
+	db sqlx.ExtContext,
+	calendarID uuid.UUID,
+	firstDay, lastDay time.Time,
+) (publicholidaymodel.PublicHolidays, error) {
 	s := &publicholidaystore.PublicHolidays{}
 
-	if err := s.Load(ctx, m.db, "strftime('%Y', day) = ?", fmt.Sprintf("%d", year)); err != nil {
+	// days are stored as text beginning with the date, so they are compared as strings
+	w := "calendar_id = ? AND day >= ? AND day < ?"
+	dayAfter := time.Date(lastDay.Year(), lastDay.Month(), lastDay.Day()+1, 0, 0, 0, 0, time.UTC)
+
+	args := []any{calendarID, firstDay.Format(time.DateOnly), dayAfter.Format(time.DateOnly)}
+	if err := s.Load(ctx, db, w, args...); err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
 	}
 
@@ -66,3 +107,92 @@ func (m *Mapper) LoadByYear(ctx context.Context, year int) (publicholidaymodel.P
 
 	return models, nil
 }
+
+// SaveMissing persists the models whose day has no public holiday yet and returns them. Existing public holidays are
+// kept untouched, so it can be called repeatedly without creating duplicates.
+func (m *Mapper) SaveMissing(ctx context.Context, models publicholidaymodel.PublicHolidays) (publicholidaymodel.PublicHolidays, error) {
+	return m.SaveByDay(ctx, models, false)
+}
+
+// SaveByDay persists the models identified by their calendar and day instead of their ID and returns the created or
+// changed ones. If there is already a public holiday on the day, it is updated with name and half day of the model if
+// update is true, otherwise it is skipped. All models are saved in one transaction, so either all or none are saved.
+func (m *Mapper) SaveByDay(
+	ctx context.Context,
+	models publicholidaymodel.PublicHolidays,
+	update bool,
+) (publicholidaymodel.PublicHolidays, error) {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	loadedYears := make(map[string]bool)
+	days := make(map[string]*publicholidaymodel.PublicHoliday) // key = calendar and day
+	saved := publicholidaymodel.PublicHolidays{}
+
+	for _, model := range models {
+		if model == nil {
+			return nil, ErrNoData
+		}
+
+		if uuidutils.IsEmpty(model.CalendarID) {
+			model.CalendarID = calendarmodel.DefaultID
+		}
+
+		keyYear := fmt.Sprintf("%s %d", model.CalendarID, model.Day.Year())
+		if !loadedYears[keyYear] {
+			existing, err := loadByPeriod(
+				ctx,
+				tx,
+				model.CalendarID,
+				time.Date(model.Day.Year(), 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(model.Day.Year(), 12, 31, 0, 0, 0, 0, time.UTC),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range existing {
+				days[dayKey(v)] = v
+			}
+
+			loadedYears[keyYear] = true
+		}
+
+		existing, ok := days[dayKey(model)]
+		if ok {
+			if !update || (existing.Name == model.Name && existing.HalfDay == model.HalfDay) {
+				continue
+			}
+
+			changed := *existing
+			changed.Name = model.Name
+			changed.HalfDay = model.HalfDay
+			model = &changed
+		} else {
+			model.ID = uuid.Nil
+		}
+
+		model, err := save(ctx, tx, model)
+		if err != nil {
+			return nil, err
+		}
+
+		days[dayKey(model)] = model
+		saved = append(saved, model)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	return saved, nil
+}
+
+// dayKey returns the key identifying the public holiday by its calendar and day.
+func dayKey(model *publicholidaymodel.PublicHoliday) string {
+	return model.CalendarID.String() + " " + model.Day.Format(time.DateOnly)
+}
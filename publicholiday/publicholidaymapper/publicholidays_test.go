@@ -0,0 +1,213 @@
+package publicholidaymapper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmapper"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmodel"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymapper"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+)
+
+func TestMapper_SaveMissing(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperSaveMissing")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	existing, err := prepareData(db, &publicholidaymodel.PublicHoliday{
+		Day:  time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC),
+		Name: "Christmas",
+	})
+	if err != nil {
+		t.Fatalf("failed to prepare data: %v", err)
+	}
+
+	mapper := publicholidaymapper.New(db)
+	ctx := context.Background()
+	models := func() publicholidaymodel.PublicHolidays {
+		return publicholidaymodel.PublicHolidays{
+			{Day: time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC), Name: "Heiligabend", HalfDay: true},
+			{Day: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC), Name: "1. Weihnachtsfeiertag"},
+			{Day: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Name: "Neujahr"},
+		}
+	}
+
+	// 2. test
+	saved, err := mapper.SaveMissing(ctx, models())
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if len(saved) != 2 || saved[0].Name != "Heiligabend" || saved[1].Name != "Neujahr" {
+		t.Errorf("expected the two missing public holidays to be saved but got %v", saved)
+	}
+
+	saved, err = mapper.SaveMissing(ctx, models())
+	if err != nil {
+		t.Fatalf("expected no error on second call but got '%v'", err)
+	}
+
+	if len(saved) != 0 {
+		t.Errorf("expected no public holidays to be saved on second call but got %d", len(saved))
+	}
+
+	// nothing is saved if one of the models fails
+	_, err = mapper.SaveMissing(ctx, publicholidaymodel.PublicHolidays{
+		{Day: time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC), Name: "2. Weihnachtsfeiertag"},
+		{CalendarID: uuid.New(), Day: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), Name: "Silvester"},
+	})
+	if !errors.Is(err, publicholidaymapper.ErrCalendarNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", publicholidaymapper.ErrCalendarNotFound, err)
+	}
+
+	loaded, err := mapper.LoadByYear(ctx, calendarmodel.DefaultID, 2024)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if len(loaded) != 2 {
+		t.Fatalf("expected 2 public holidays in 2024 but got %d", len(loaded))
+	}
+
+	assertPublicHoliday(t, existing, loaded[1])
+}
+
+func TestMapper_SaveByDay(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperSaveByDay")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	existing, err := prepareData(db, &publicholidaymodel.PublicHoliday{
+		Day:  time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC),
+		Name: "Christmas Eve",
+	})
+	if err != nil {
+		t.Fatalf("failed to prepare data: %v", err)
+	}
+
+	mapper := publicholidaymapper.New(db)
+	ctx := context.Background()
+
+	// 2. test
+	saved, err := mapper.SaveByDay(ctx, publicholidaymodel.PublicHolidays{
+		{Day: time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC), Name: "Heiligabend", HalfDay: true},
+		{Day: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC), Name: "1. Weihnachtsfeiertag"},
+	}, true)
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if len(saved) != 2 {
+		t.Fatalf("expected 2 changed public holidays but got %d", len(saved))
+	}
+
+	if saved[0].ID != existing.ID || saved[0].Name != "Heiligabend" || !saved[0].HalfDay {
+		t.Errorf("expected existing public holiday to be updated but got %+v", saved[0])
+	}
+
+	saved, err = mapper.SaveByDay(ctx, publicholidaymodel.PublicHolidays{
+		{Day: time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC), Name: "Heiligabend", HalfDay: true},
+	}, true)
+	if err != nil {
+		t.Fatalf("expected no error on unchanged data but got '%v'", err)
+	}
+
+	if len(saved) != 0 {
+		t.Errorf("expected unchanged public holidays not to be saved but got %d", len(saved))
+	}
+}
+
+func TestMapper_Calendars(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperCalendars")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	ctx := context.Background()
+
+	calendar, err := calendarmapper.New(db).Save(ctx, &calendarmodel.Calendar{Name: "Bayern"})
+	if err != nil {
+		t.Fatalf("failed to prepare calendar: %v", err)
+	}
+
+	mapper := publicholidaymapper.New(db)
+	day := time.Date(2024, 8, 15, 0, 0, 0, 0, time.UTC)
+
+	// 2. test
+	if _, err := mapper.Save(ctx, &publicholidaymodel.PublicHoliday{Day: day, Name: "Mariä Himmelfahrt"}); err != nil {
+		t.Fatalf("expected no error on save in default calendar but got '%v'", err)
+	}
+
+	saved, err := mapper.Save(ctx, &publicholidaymodel.PublicHoliday{
+		CalendarID: calendar.ID,
+		Day:        day,
+		Name:       "Mariä Himmelfahrt",
+	})
+	if err != nil {
+		t.Fatalf("expected no error on save of same day in other calendar but got '%v'", err)
+	}
+
+	if saved.CalendarID != calendar.ID {
+		t.Errorf("expected calendar %s but got %s", calendar.ID, saved.CalendarID)
+	}
+
+	_, err = mapper.Save(ctx, &publicholidaymodel.PublicHoliday{CalendarID: calendar.ID, Day: day, Name: "Friedensfest"})
+	if !errors.Is(err, publicholidaymapper.ErrDuplicateDay) {
+		t.Errorf("expected error '%v' but got '%v'", publicholidaymapper.ErrDuplicateDay, err)
+	}
+
+	for _, calendarID := range []uuid.UUID{calendarmodel.DefaultID, calendar.ID} {
+		loaded, err := mapper.LoadByYear(ctx, calendarID, 2024)
+		if err != nil {
+			t.Fatalf("expected no error on load but got '%v'", err)
+		}
+
+		if len(loaded) != 1 || loaded[0].CalendarID != calendarID {
+			t.Errorf("expected 1 public holiday in calendar %s but got %v", calendarID, loaded)
+		}
+	}
+
+	if err := calendarmapper.New(db).Delete(ctx, calendar.ID); err != nil {
+		t.Fatalf("expected no error on deleting calendar but got '%v'", err)
+	}
+
+	if _, err := mapper.Load(ctx, saved.ID); !errors.Is(err, publicholidaymapper.ErrNotFound) {
+		t.Errorf("expected public holidays of deleted calendar to be deleted but got error '%v'", err)
+	}
+}
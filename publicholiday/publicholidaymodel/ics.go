@@ -0,0 +1,317 @@
+package publicholidaymodel
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	icsDateFormat     = "20060102"
+	icsDateTimeFormat = "20060102T150405"
+)
+
+var (
+	// ErrICSRead occurs if the iCalendar data is malformed.
+	ErrICSRead = errors.New("failed to read iCalendar")
+
+	// ErrICSInvalidDate occurs if a date of an event has no known format.
+	ErrICSInvalidDate = errors.New("invalid iCalendar date")
+
+	// ErrICSInvalidDuration occurs if the duration of an event has no known format.
+	ErrICSInvalidDuration = errors.New("invalid iCalendar duration")
+
+	// ErrICSInvalidTimezone occurs if the time zone of a date is unknown.
+	ErrICSInvalidTimezone = errors.New("invalid iCalendar time zone")
+
+	// icsHalfDayMarkers mark an all-day event as half day if its summary contains one of them.
+	icsHalfDayMarkers = []string{"half day", "half-day", "halbtag", "halber tag", "½"}
+)
+
+// icsEvent holds the properties of a VEVENT needed for a public holiday.
+type icsEvent struct {
+	summary   string
+	start     string
+	startDate bool
+	startTZID string
+	end       string
+	endDate   bool
+	endTZID   string
+	duration  string
+	cancelled bool
+}
+
+// ReadICS reads public holidays from iCalendar data. Every day of an all-day event becomes a public holiday. It is a
+// half day if the summary says so. Events with a time are half days if they are shorter than a day, others are
+// ignored. The end of an event is taken from DTEND or from DURATION. Cancelled events are ignored as well as the
+// properties of components nested in an event, e.g. VALARM.
+func ReadICS(reader io.Reader) (PublicHolidays, error) {
+	lines, err := unfoldICS(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays := PublicHolidays{}
+
+	var (
+		event  *icsEvent
+		nested int
+	)
+
+	for _, line := range lines {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		// parameter values like the time zone are case-sensitive
+		name, params, _ := strings.Cut(name, ";")
+		name = strings.ToUpper(name)
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			event = &icsEvent{} // nolint: exhaustivestruct
+		case event == nil:
+			continue
+		case name == "BEGIN":
+			nested++
+		case nested > 0:
+			if name == "END" {
+				nested--
+			}
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			h, err := event.toPublicHolidays()
+			if err != nil {
+				return nil, err
+			}
+
+			holidays = append(holidays, h...)
+			event = nil
+		case name == "SUMMARY":
+			event.summary = unescapeICS(value)
+		case name == "DTSTART":
+			event.start = value
+			event.startDate = strings.EqualFold(icsParam(params, "VALUE"), "DATE")
+			event.startTZID = icsParam(params, "TZID")
+		case name == "DTEND":
+			event.end = value
+			event.endDate = strings.EqualFold(icsParam(params, "VALUE"), "DATE")
+			event.endTZID = icsParam(params, "TZID")
+		case name == "DURATION":
+			event.duration = value
+		case name == "STATUS":
+			event.cancelled = strings.EqualFold(value, "CANCELLED")
+		}
+	}
+
+	if event != nil {
+		return nil, fmt.Errorf("%w: VEVENT is not closed", ErrICSRead)
+	}
+
+	return holidays, nil
+}
+
+// toPublicHolidays returns a public holiday for every day of the event.
+func (e *icsEvent) toPublicHolidays() (PublicHolidays, error) {
+	if e.cancelled || e.start == "" {
+		return nil, nil
+	}
+
+	if !e.startDate && len(e.start) > len(icsDateFormat) {
+		return e.timedToPublicHolidays()
+	}
+
+	first, err := time.Parse(icsDateFormat, e.start)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrICSInvalidDate, e.start)
+	}
+
+	// the end of all-day events is exclusive
+	last := first
+
+	switch {
+	case e.end != "":
+		end, err := time.Parse(icsDateFormat, e.end)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrICSInvalidDate, e.end)
+		}
+
+		if end.After(first) {
+			last = end.AddDate(0, 0, -1)
+		}
+	case e.duration != "":
+		duration, err := parseICSDuration(e.duration)
+		if err != nil {
+			return nil, err
+		}
+
+		if days := int(duration / (24 * time.Hour)); days > 0 {
+			last = first.AddDate(0, 0, days-1)
+		}
+	}
+
+	halfDay := false
+	summary := strings.ToLower(e.summary)
+
+	for _, marker := range icsHalfDayMarkers {
+		if strings.Contains(summary, marker) {
+			halfDay = true
+
+			break
+		}
+	}
+
+	holidays := PublicHolidays{}
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		holidays = append(holidays, &PublicHoliday{Day: day, Name: e.summary, HalfDay: halfDay}) // nolint: exhaustivestruct
+	}
+
+	return holidays, nil
+}
+
+// timedToPublicHolidays returns a half-day public holiday if the event lasts less than a day. An event without end
+// and duration lasts no time and is ignored.
+func (e *icsEvent) timedToPublicHolidays() (PublicHolidays, error) {
+	start, err := parseICSDateTime(e.start, e.startTZID)
+	if err != nil {
+		return nil, err
+	}
+
+	end := start
+
+	switch {
+	case e.end != "":
+		end, err = parseICSDateTime(e.end, e.endTZID)
+		if err != nil {
+			return nil, err
+		}
+	case e.duration != "":
+		duration, err := parseICSDuration(e.duration)
+		if err != nil {
+			return nil, err
+		}
+
+		end = start.Add(duration)
+	}
+
+	if !end.After(start) || end.Sub(start) >= 24*time.Hour {
+		return nil, nil
+	}
+
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	return PublicHolidays{{Day: day, Name: e.summary, HalfDay: true}}, nil // nolint: exhaustivestruct
+}
+
+// parseICSDateTime returns the date with time. It is in UTC if it ends with Z, in the location of the given time zone
+// ID or floating without one.
+func parseICSDateTime(value, tzid string) (time.Time, error) {
+	loc := time.UTC
+
+	if !strings.HasSuffix(value, "Z") && tzid != "" {
+		var err error
+
+		loc, err = time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %q", ErrICSInvalidTimezone, tzid)
+		}
+	}
+
+	t, err := time.ParseInLocation(icsDateTimeFormat, strings.TrimSuffix(value, "Z"), loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrICSInvalidDate, value)
+	}
+
+	return t, nil
+}
+
+// parseICSDuration returns the duration of a value like P1D or PT4H30M. Weeks and days are counted with 24 hours.
+func parseICSDuration(value string) (time.Duration, error) {
+	rest := strings.TrimPrefix(strings.TrimPrefix(strings.ToUpper(value), "+"), "-")
+	if !strings.HasPrefix(rest, "P") || len(rest) < 3 { // nolint: gomnd
+		return 0, fmt.Errorf("%w: %q", ErrICSInvalidDuration, value)
+	}
+
+	units := map[byte]time.Duration{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour}
+	timeUnits := map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}
+
+	var (
+		duration time.Duration
+		number   int
+		digits   bool
+	)
+
+	for _, c := range []byte(rest[1:]) {
+		switch {
+		case c >= '0' && c <= '9':
+			number = number*10 + int(c-'0') // nolint: gomnd
+			digits = true
+		case c == 'T' && !digits:
+			units = timeUnits
+		case units[c] > 0 && digits:
+			duration += time.Duration(number) * units[c]
+			number, digits = 0, false
+		default:
+			return 0, fmt.Errorf("%w: %q", ErrICSInvalidDuration, value)
+		}
+	}
+
+	if digits {
+		return 0, fmt.Errorf("%w: %q", ErrICSInvalidDuration, value)
+	}
+
+	if strings.HasPrefix(value, "-") {
+		return -duration, nil
+	}
+
+	return duration, nil
+}
+
+// icsParam returns the value of the parameter with the given name, e.g. Europe/Berlin for TZID=Europe/Berlin.
+func icsParam(params, name string) string {
+	for _, v := range strings.Split(params, ";") {
+		if key, value, ok := strings.Cut(v, "="); ok && strings.EqualFold(key, name) {
+			return strings.Trim(value, `"`)
+		}
+	}
+
+	return ""
+}
+
+// unfoldICS returns the content lines of iCalendar data. Lines starting with a space or tab continue the previous one.
+func unfoldICS(reader io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+
+			continue
+		}
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrICSRead, err)
+	}
+
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, fmt.Errorf("%w: data must start with BEGIN:VCALENDAR", ErrICSRead)
+	}
+
+	return lines, nil
+}
+
+// unescapeICS removes the escaping of a text value.
+func unescapeICS(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
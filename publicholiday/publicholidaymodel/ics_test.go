@@ -0,0 +1,157 @@
+package publicholidaymodel_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+)
+
+func TestReadICS(t *testing.T) {
+	t.Parallel()
+
+	calendar := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//ACME//Holidays//EN",
+		"BEGIN:VEVENT",
+		"UID:1@acme",
+		"DTSTART;VALUE=DATE:20241224",
+		"DTEND;VALUE=DATE:20241225",
+		"SUMMARY:Heiligabend (half day)",
+		"BEGIN:VALARM",
+		"ACTION:EMAIL",
+		"TRIGGER:-P1D",
+		"SUMMARY:Reminder",
+		"DESCRIPTION:Christmas is coming",
+		"DTSTART;VALUE=DATE:20241201",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:2@acme",
+		"SUMMARY:Weihnachten\\, Feiertage",
+		"DTSTART;VALUE=DATE:20241225",
+		"DTEND;VALUE=DATE:20241227",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:3@acme",
+		"DTSTART;TZID=Europe/Berlin:20241231T120000",
+		"DTEND;TZID=Europe/Berlin:20241231T235959",
+		"SUMMARY:Silves",
+		" ter",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:4@acme",
+		"DTSTART:20240601T090000Z",
+		"DTEND:20240603T090000Z",
+		"SUMMARY:Offsite",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:5@acme",
+		"DTSTART;VALUE=DATE:20241001",
+		"SUMMARY:Cancelled",
+		"STATUS:CANCELLED",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:6@acme",
+		"DTSTART;VALUE=DATE:20241003",
+		"DURATION:P2D",
+		"SUMMARY:Brückentage",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:7@acme",
+		"DTSTART;TZID=Europe/Berlin:20241231T000000",
+		"DURATION:PT4H30M",
+		"SUMMARY:Betriebsausflug",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:8@acme",
+		"DTSTART;TZID=America/New_York:20240605T080000",
+		"DTEND;TZID=Europe/Berlin:20240606T100000",
+		"SUMMARY:Across time zones",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:9@acme",
+		"DTSTART:20240607T090000",
+		"SUMMARY:No time",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	actual, err := publicholidaymodel.ReadICS(strings.NewReader(calendar))
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	expected := publicholidaymodel.PublicHolidays{
+		{Day: time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC), Name: "Heiligabend (half day)", HalfDay: true},
+		{Day: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC), Name: "Weihnachten, Feiertage"},
+		{Day: time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC), Name: "Weihnachten, Feiertage"},
+		{Day: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), Name: "Silvester", HalfDay: true},
+		{Day: time.Date(2024, 10, 3, 0, 0, 0, 0, time.UTC), Name: "Brückentage"},
+		{Day: time.Date(2024, 10, 4, 0, 0, 0, 0, time.UTC), Name: "Brückentage"},
+		{Day: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), Name: "Betriebsausflug", HalfDay: true},
+		{Day: time.Date(2024, 6, 5, 0, 0, 0, 0, time.UTC), Name: "Across time zones", HalfDay: true},
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d public holidays but got %d", len(expected), len(actual))
+	}
+
+	for i, v := range expected {
+		assertPublicHoliday(t, v, actual[i])
+	}
+}
+
+func TestReadICS_Errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		ics         string
+		expectedErr error
+	}{
+		{
+			name:        "empty",
+			ics:         "",
+			expectedErr: publicholidaymodel.ErrICSRead,
+		},
+		{
+			name:        "no calendar",
+			ics:         "start,stop\n",
+			expectedErr: publicholidaymodel.ErrICSRead,
+		},
+		{
+			name:        "event not closed",
+			ics:         "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20241224\n",
+			expectedErr: publicholidaymodel.ErrICSRead,
+		},
+		{
+			name:        "invalid date",
+			ics:         "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:2024-12-24\nEND:VEVENT\nEND:VCALENDAR\n",
+			expectedErr: publicholidaymodel.ErrICSInvalidDate,
+		},
+		{
+			name:        "invalid duration",
+			ics:         "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20241224T090000\nDURATION:4H\nEND:VEVENT\n",
+			expectedErr: publicholidaymodel.ErrICSInvalidDuration,
+		},
+		{
+			name:        "invalid time zone",
+			ics:         "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;TZID=Mars/Olympus:20241224T090000\nEND:VEVENT\n",
+			expectedErr: publicholidaymodel.ErrICSInvalidTimezone,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := publicholidaymodel.ReadICS(strings.NewReader(testCase.ics)); !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+		})
+	}
+}
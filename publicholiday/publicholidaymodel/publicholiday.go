@@ -10,12 +10,30 @@ import (
 	"github.com/google/uuid"
 )
 
-// ErrDecodeJSON occurs if a string is not in JSON format.
-var ErrDecodeJSON = errors.New("failed to decode JSON")
+// MaxLengthName defines the maximum number of characters of the public holiday name.
+const MaxLengthName = 250
+
+var (
+	// ErrDecodeJSON occurs if a string is not in JSON format.
+	ErrDecodeJSON = errors.New("failed to decode JSON")
+
+	// ErrValidationNameMandatory occurs during validation if the name wasn't set.
+	ErrValidationNameMandatory = errors.New("name should not be empty")
+
+	// ErrValidationNameTooLong occurs during validation if the name exceeds its maximum length.
+	ErrValidationNameTooLong = fmt.Errorf("name has more than %d characters", MaxLengthName)
+
+	// ErrValidationDayMandatory occurs during validation if the day wasn't set.
+	ErrValidationDayMandatory = errors.New("day should not be empty")
+
+	// ErrValidationDayNoDate occurs during validation if the day has a time component.
+	ErrValidationDayNoDate = errors.New("day should be a date without time")
+)
 
 // PublicHoliday represents a model of repository including business logic.
 type PublicHoliday struct {
 	ID         uuid.UUID `json:"ID"`
+	CalendarID uuid.UUID `json:"CalendarID"`
 	Day        time.Time `json:"Day"`
 	Name       string    `json:"Name"`
 	HalfDay    bool      `json:"HalfDay"`
@@ -36,3 +54,34 @@ func (r *PublicHoliday) DecodeJSON(reader io.Reader) error {
 
 	return nil
 }
+
+// Validate is validating the attributes of the struct to valid values. If the validation fails it returns the reason
+// why it failed in the error message.
+func (r *PublicHoliday) Validate() error {
+	if r.Name == "" {
+		return ErrValidationNameMandatory
+	}
+
+	if len([]rune(r.Name)) > MaxLengthName {
+		return ErrValidationNameTooLong
+	}
+
+	if r.Day.IsZero() {
+		return ErrValidationDayMandatory
+	}
+
+	if r.Day.Hour() != 0 || r.Day.Minute() != 0 || r.Day.Second() != 0 || r.Day.Nanosecond() != 0 {
+		return ErrValidationDayNoDate
+	}
+
+	return nil
+}
+
+// DaysOff returns the fraction of a workday which is off because of the public holiday: 0.5 for half days, otherwise 1.
+func (r *PublicHoliday) DaysOff() float64 {
+	if r.HalfDay {
+		return 0.5 // nolint: gomnd
+	}
+
+	return 1
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"strings"
 	"testing"
 	"time"
 
@@ -83,6 +84,60 @@ func TestPublicHoliday_DecodeJSON(t *testing.T) {
 	}
 }
 
+func TestPublicHoliday_Validate(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		publicHoliday *publicholidaymodel.PublicHoliday
+		expectedErr   error
+	}{
+		{
+			name:          "name missing",
+			publicHoliday: &publicholidaymodel.PublicHoliday{Day: day},
+			expectedErr:   publicholidaymodel.ErrValidationNameMandatory,
+		},
+		{
+			name: "name too long",
+			publicHoliday: &publicholidaymodel.PublicHoliday{
+				Day:  day,
+				Name: strings.Repeat("a", publicholidaymodel.MaxLengthName+1),
+			},
+			expectedErr: publicholidaymodel.ErrValidationNameTooLong,
+		},
+		{
+			name:          "day missing",
+			publicHoliday: &publicholidaymodel.PublicHoliday{Name: "Tag der Arbeit"},
+			expectedErr:   publicholidaymodel.ErrValidationDayMandatory,
+		},
+		{
+			name:          "day has time",
+			publicHoliday: &publicholidaymodel.PublicHoliday{Day: day.Add(time.Hour), Name: "Tag der Arbeit"},
+			expectedErr:   publicholidaymodel.ErrValidationDayNoDate,
+		},
+		{
+			name: "valid",
+			publicHoliday: &publicholidaymodel.PublicHoliday{
+				Day:  day,
+				Name: strings.Repeat("ä", publicholidaymodel.MaxLengthName),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := testCase.publicHoliday.Validate(); !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
 func assertPublicHoliday(t *testing.T, expected, actual *publicholidaymodel.PublicHoliday) {
 	t.Helper()
 
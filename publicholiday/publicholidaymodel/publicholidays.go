@@ -5,3 +5,15 @@ type Year int
 type PublicHolidaysByYear map[Year][]*PublicHoliday
 
 type PublicHolidays []*PublicHoliday
+
+// ByYear returns the public holidays grouped by their year.
+func (p PublicHolidays) ByYear() PublicHolidaysByYear {
+	byYear := make(PublicHolidaysByYear)
+
+	for _, v := range p {
+		year := Year(v.Day.Year())
+		byYear[year] = append(byYear[year], v)
+	}
+
+	return byYear
+}
@@ -0,0 +1,73 @@
+package publicholidayrules
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rebel-l/go-utils/slice"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+)
+
+// ErrUnknownRegion occurs if there are no rules for the requested region.
+var ErrUnknownRegion = errors.New("unknown region")
+
+// Country contains the rules of all public holidays of a country. The country code is the ISO 3166-1 code, the regions
+// are ISO 3166-2 codes.
+type Country struct {
+	Code    string
+	Regions slice.StringSlice
+	Rules   []*Rule
+}
+
+var countries = map[string]*Country{
+	germany.Code: germany,
+}
+
+// Regions returns all regions public holidays can be generated for. A country code selects the holidays common to all
+// regions of the country.
+func Regions() []string {
+	var regions []string
+
+	for code, country := range countries {
+		regions = append(regions, code)
+		regions = append(regions, country.Regions...)
+	}
+
+	sort.Strings(regions)
+
+	return regions
+}
+
+// Generate returns the public holidays of the region in the given year ordered by day. The region is either a country
+// code like "DE" or a region code like "DE-BY".
+func Generate(year int, region string) (publicholidaymodel.PublicHolidays, error) {
+	region = strings.ToUpper(region)
+	code, _, _ := strings.Cut(region, "-")
+
+	country, ok := countries[code]
+	if !ok || (region != code && country.Regions.IsNotIn(region)) {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownRegion, region)
+	}
+
+	holidays := publicholidaymodel.PublicHolidays{}
+
+	for _, rule := range country.Rules {
+		if !rule.AppliesTo(region, year) {
+			continue
+		}
+
+		holidays = append(holidays, &publicholidaymodel.PublicHoliday{ // nolint: exhaustivestruct
+			Day:     rule.Date(year),
+			Name:    rule.Name,
+			HalfDay: rule.HalfDay,
+		})
+	}
+
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Day.Before(holidays[j].Day)
+	})
+
+	return holidays, nil
+}
@@ -0,0 +1,130 @@
+package publicholidayrules_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidayrules"
+)
+
+func TestEaster(t *testing.T) {
+	t.Parallel()
+
+	expected := map[int]time.Time{
+		2019: time.Date(2019, 4, 21, 0, 0, 0, 0, time.UTC),
+		2024: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		2025: time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC),
+		2026: time.Date(2026, 4, 5, 0, 0, 0, 0, time.UTC),
+		2038: time.Date(2038, 4, 25, 0, 0, 0, 0, time.UTC),
+	}
+
+	for year, day := range expected {
+		if actual := publicholidayrules.Easter(year); !actual.Equal(day) {
+			t.Errorf("expected Easter %d on %s but got %s", year, day.Format(time.DateOnly), actual.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		year        int
+		region      string
+		expected    map[string]string // key = day, value = name
+		expectedLen int
+		expectedErr error
+	}{
+		{
+			name:        "unknown country",
+			year:        2024,
+			region:      "XX",
+			expectedErr: publicholidayrules.ErrUnknownRegion,
+		},
+		{
+			name:        "unknown region",
+			year:        2024,
+			region:      "DE-XX",
+			expectedErr: publicholidayrules.ErrUnknownRegion,
+		},
+		{
+			name:   "nationwide",
+			year:   2024,
+			region: "DE",
+			expected: map[string]string{
+				"2024-03-29": "Karfreitag",
+				"2024-04-01": "Ostermontag",
+				"2024-05-09": "Christi Himmelfahrt",
+				"2024-05-20": "Pfingstmontag",
+			},
+			expectedLen: 9,
+		},
+		{
+			name:   "Bavaria",
+			year:   2024,
+			region: "de-by",
+			expected: map[string]string{
+				"2024-01-06": "Heilige Drei Könige",
+				"2024-05-30": "Fronleichnam",
+				"2024-11-01": "Allerheiligen",
+			},
+			expectedLen: 12,
+		},
+		{
+			name:        "Berlin before women's day",
+			year:        2018,
+			region:      "DE-BE",
+			expectedLen: 9,
+		},
+		{
+			name:        "Berlin since women's day",
+			year:        2019,
+			region:      "DE-BE",
+			expected:    map[string]string{"2019-03-08": "Internationaler Frauentag"},
+			expectedLen: 10,
+		},
+		{
+			name:        "Saxony",
+			year:        2024,
+			region:      "DE-SN",
+			expected:    map[string]string{"2024-11-20": "Buß- und Bettag", "2024-10-31": "Reformationstag"},
+			expectedLen: 11,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := publicholidayrules.Generate(testCase.year, testCase.region)
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+
+			if len(actual) != testCase.expectedLen {
+				t.Errorf("expected %d public holidays but got %d", testCase.expectedLen, len(actual))
+			}
+
+			days := make(map[string]string)
+			for i, v := range actual {
+				days[v.Day.Format(time.DateOnly)] = v.Name
+
+				if i > 0 && !actual[i-1].Day.Before(v.Day) {
+					t.Errorf("expected public holidays ordered by day but %s is not before %s", actual[i-1].Day, v.Day)
+				}
+
+				if v.HalfDay {
+					t.Errorf("expected only legal public holidays but got half day %s", v.Name)
+				}
+			}
+
+			for day, name := range testCase.expected {
+				if days[day] != name {
+					t.Errorf("expected %q on %s but got %q", name, day, days[day])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package publicholidayrules
+
+import "time"
+
+// Regions of Germany as ISO 3166-2 codes.
+const (
+	RegionBadenWuerttemberg     = "DE-BW"
+	RegionBavaria               = "DE-BY"
+	RegionBerlin                = "DE-BE"
+	RegionBrandenburg           = "DE-BB"
+	RegionBremen                = "DE-HB"
+	RegionHamburg               = "DE-HH"
+	RegionHesse                 = "DE-HE"
+	RegionMecklenburgVorpommern = "DE-MV"
+	RegionLowerSaxony           = "DE-NI"
+	RegionNorthRhineWestphalia  = "DE-NW"
+	RegionRhinelandPalatinate   = "DE-RP"
+	RegionSaarland              = "DE-SL"
+	RegionSaxony                = "DE-SN"
+	RegionSaxonyAnhalt          = "DE-ST"
+	RegionSchleswigHolstein     = "DE-SH"
+	RegionThuringia             = "DE-TH"
+)
+
+// germany contains the legal public holidays of all German states. Christmas Eve and New Year's Eve are no legal
+// holidays, companies giving half days off have to add them to their calendars themselves.
+var germany = &Country{
+	Code: "DE",
+	Regions: []string{
+		RegionBadenWuerttemberg, RegionBavaria, RegionBerlin, RegionBrandenburg, RegionBremen, RegionHamburg,
+		RegionHesse, RegionMecklenburgVorpommern, RegionLowerSaxony, RegionNorthRhineWestphalia,
+		RegionRhinelandPalatinate, RegionSaarland, RegionSaxony, RegionSaxonyAnhalt, RegionSchleswigHolstein,
+		RegionThuringia,
+	},
+	Rules: []*Rule{
+		Fixed("Neujahr", time.January, 1),
+		Fixed("Heilige Drei Könige", time.January, 6).In(RegionBadenWuerttemberg, RegionBavaria, RegionSaxonyAnhalt),
+		Fixed("Internationaler Frauentag", time.March, 8).In(RegionBerlin).Since(2019),                // nolint: gomnd
+		Fixed("Internationaler Frauentag", time.March, 8).In(RegionMecklenburgVorpommern).Since(2023), // nolint: gomnd
+		EasterRelative("Karfreitag", -2),
+		EasterRelative("Ostersonntag", 0).In(RegionBrandenburg),
+		EasterRelative("Ostermontag", 1),
+		Fixed("Tag der Arbeit", time.May, 1),
+		EasterRelative("Christi Himmelfahrt", 39),                  // nolint: gomnd
+		EasterRelative("Pfingstsonntag", 49).In(RegionBrandenburg), // nolint: gomnd
+		EasterRelative("Pfingstmontag", 50),                        // nolint: gomnd
+		EasterRelative("Fronleichnam", 60).In( // nolint: gomnd
+			RegionBadenWuerttemberg, RegionBavaria, RegionHesse, RegionNorthRhineWestphalia, RegionRhinelandPalatinate,
+			RegionSaarland,
+		),
+		Fixed("Mariä Himmelfahrt", time.August, 15).In(RegionSaarland),
+		Fixed("Weltkindertag", time.September, 20).In(RegionThuringia).Since(2019), // nolint: gomnd
+		Fixed("Tag der Deutschen Einheit", time.October, 3),
+		Fixed("Reformationstag", time.October, 31).In(
+			RegionBrandenburg, RegionMecklenburgVorpommern, RegionSaxony, RegionSaxonyAnhalt, RegionThuringia,
+		),
+		Fixed("Reformationstag", time.October, 31).In(
+			RegionBremen, RegionHamburg, RegionLowerSaxony, RegionSchleswigHolstein,
+		).Since(2018), // nolint: gomnd
+		Fixed("Allerheiligen", time.November, 1).In(
+			RegionBadenWuerttemberg, RegionBavaria, RegionNorthRhineWestphalia, RegionRhinelandPalatinate,
+			RegionSaarland,
+		),
+		Computed("Buß- und Bettag", repentanceDay).In(RegionSaxony),
+		Fixed("1. Weihnachtsfeiertag", time.December, 25),
+		Fixed("2. Weihnachtsfeiertag", time.December, 26),
+	},
+}
+
+// repentanceDay returns the Day of Repentance and Prayer, the last Wednesday before November 23rd.
+func repentanceDay(year int) time.Time {
+	day := time.Date(year, time.November, 22, 0, 0, 0, 0, time.UTC)
+
+	return day.AddDate(0, 0, -((int(day.Weekday()) - int(time.Wednesday) + 7) % 7)) // nolint: gomnd
+}
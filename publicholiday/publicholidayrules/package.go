@@ -0,0 +1,2 @@
+// Package publicholidayrules provides the rules to compute public holidays per country and region.
+package publicholidayrules
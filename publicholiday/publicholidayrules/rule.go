@@ -0,0 +1,98 @@
+package publicholidayrules
+
+import (
+	"time"
+
+	"github.com/rebel-l/go-utils/slice"
+)
+
+// Rule describes a public holiday. It computes the day of the holiday for a year and knows in which regions and since
+// which year it applies.
+type Rule struct {
+	Name    string
+	HalfDay bool
+	date    func(year int) time.Time
+	regions slice.StringSlice
+	since   int
+}
+
+// Fixed returns a rule for a holiday on the same day every year.
+func Fixed(name string, month time.Month, day int) *Rule {
+	return &Rule{ // nolint: exhaustivestruct
+		Name: name,
+		date: func(year int) time.Time {
+			return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		},
+	}
+}
+
+// EasterRelative returns a rule for a holiday the given number of days after (or before if negative) Easter Sunday.
+func EasterRelative(name string, offset int) *Rule {
+	return &Rule{ // nolint: exhaustivestruct
+		Name: name,
+		date: func(year int) time.Time {
+			return Easter(year).AddDate(0, 0, offset)
+		},
+	}
+}
+
+// Computed returns a rule for a holiday computed by the given function.
+func Computed(name string, date func(year int) time.Time) *Rule {
+	return &Rule{Name: name, date: date} // nolint: exhaustivestruct
+}
+
+// In restricts the rule to the given regions. Without regions it applies to the whole country.
+func (r *Rule) In(regions ...string) *Rule {
+	r.regions = regions
+
+	return r
+}
+
+// Since restricts the rule to the given year and all following years.
+func (r *Rule) Since(year int) *Rule {
+	r.since = year
+
+	return r
+}
+
+// AsHalfDay marks the holiday as half day off.
+func (r *Rule) AsHalfDay() *Rule {
+	r.HalfDay = true
+
+	return r
+}
+
+// Date returns the day of the holiday in the given year.
+func (r *Rule) Date(year int) time.Time {
+	return r.date(year)
+}
+
+// AppliesTo returns true if the holiday applies to the region in the given year.
+func (r *Rule) AppliesTo(region string, year int) bool {
+	if year < r.since {
+		return false
+	}
+
+	return len(r.regions) == 0 || r.regions.IsIn(region)
+}
+
+// Easter returns Easter Sunday of the given year in the Gregorian calendar.
+func Easter(year int) time.Time {
+	// anonymous Gregorian algorithm (Meeus/Jones/Butcher)
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
@@ -2,6 +2,7 @@ package publicholidaystore
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"time"
@@ -13,7 +14,7 @@ import (
 
 const (
 	qSelect = `
-        SELECT id, day, name, halfday, created_at, modified_at
+        SELECT id, calendar_id, day, name, halfday, created_at, modified_at
         FROM publicholidays
     `
 )
@@ -35,6 +36,7 @@ var (
 // PublicHoliday represents the publicholiday in the database.
 type PublicHoliday struct {
 	ID         uuid.UUID `db:"id"`
+	CalendarID uuid.UUID `db:"calendar_id"`
 	Day        time.Time `db:"day"`
 	Name       string    `db:"name"`
 	HalfDay    bool      `db:"halfday"`
@@ -43,7 +45,7 @@ type PublicHoliday struct {
 }
 
 // Create creates current object in the database.
-func (p *PublicHoliday) Create(ctx context.Context, db *sqlx.DB) error {
+func (p *PublicHoliday) Create(ctx context.Context, db sqlx.ExtContext) error {
 	if !p.IsValid() {
 		return ErrDataMissing
 	}
@@ -60,11 +62,11 @@ func (p *PublicHoliday) Create(ctx context.Context, db *sqlx.DB) error {
 	}
 
 	q := db.Rebind(`
-		INSERT INTO publicholidays (id, day, name, halfday) 
-		VALUES (?, ?, ?, ?);
+		INSERT INTO publicholidays (id, calendar_id, day, name, halfday) 
+		VALUES (?, ?, ?, ?, ?);
 	`)
 
-	_, err = db.ExecContext(ctx, q, p.ID, p.Day, p.Name, p.HalfDay)
+	_, err = db.ExecContext(ctx, q, p.ID, p.CalendarID, p.Day, p.Name, p.HalfDay)
 	if err != nil {
 		return fmt.Errorf("failed to create: %w", err)
 	}
@@ -73,7 +75,7 @@ func (p *PublicHoliday) Create(ctx context.Context, db *sqlx.DB) error {
 }
 
 // Read sets the publicholiday from database by given ID.
-func (p *PublicHoliday) Read(ctx context.Context, db *sqlx.DB) error {
+func (p *PublicHoliday) Read(ctx context.Context, db sqlx.ExtContext) error {
 	if p == nil || uuidutils.IsEmpty(p.ID) {
 		return ErrIDMissing
 	}
@@ -83,7 +85,7 @@ func (p *PublicHoliday) Read(ctx context.Context, db *sqlx.DB) error {
         WHERE id = ?;
     `)
 
-	if err := db.GetContext(ctx, p, q, p.ID); err != nil {
+	if err := sqlx.GetContext(ctx, db, p, q, p.ID); err != nil {
 		return fmt.Errorf("failed to read: %w", err)
 	}
 
@@ -91,7 +93,7 @@ func (p *PublicHoliday) Read(ctx context.Context, db *sqlx.DB) error {
 }
 
 // Update changes the current object on the database by ID.
-func (p *PublicHoliday) Update(ctx context.Context, db *sqlx.DB) error {
+func (p *PublicHoliday) Update(ctx context.Context, db sqlx.ExtContext) error {
 	if !p.IsValid() {
 		return ErrDataMissing
 	}
@@ -102,11 +104,11 @@ func (p *PublicHoliday) Update(ctx context.Context, db *sqlx.DB) error {
 
 	q := db.Rebind(`
 		UPDATE publicholidays 
-		SET day = ?, name = ?, halfday = ? 
+		SET calendar_id = ?, day = ?, name = ?, halfday = ? 
 		WHERE id = ?;
 	`)
 
-	_, err := db.ExecContext(ctx, q, p.Day, p.Name, p.HalfDay, p.ID)
+	_, err := db.ExecContext(ctx, q, p.CalendarID, p.Day, p.Name, p.HalfDay, p.ID)
 	if err != nil {
 		return fmt.Errorf("failed to update: %w", err)
 	}
@@ -114,8 +116,8 @@ func (p *PublicHoliday) Update(ctx context.Context, db *sqlx.DB) error {
 	return p.Read(ctx, db)
 }
 
-// Delete removes the current object from database by its ID.
-func (p *PublicHoliday) Delete(ctx context.Context, db *sqlx.DB) error {
+// Delete removes the current object from database by its ID. It returns sql.ErrNoRows if the object doesn't exist.
+func (p *PublicHoliday) Delete(ctx context.Context, db sqlx.ExtContext) error {
 	if p == nil || uuidutils.IsEmpty(p.ID) {
 		return ErrIDMissing
 	}
@@ -125,16 +127,26 @@ func (p *PublicHoliday) Delete(ctx context.Context, db *sqlx.DB) error {
         WHERE id = ?
     `)
 
-	if _, err := db.ExecContext(ctx, q, p.ID); err != nil {
+	res, err := db.ExecContext(ctx, q, p.ID)
+	if err != nil {
 		return fmt.Errorf("failed to delete: %w", err)
 	}
 
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
 	return nil
 }
 
 // IsValid returns true if all mandatory fields are set.
 func (p *PublicHoliday) IsValid() bool {
-	if p == nil || p.Day.IsZero() || p.Name == "" {
+	if p == nil || uuidutils.IsEmpty(p.CalendarID) || p.Day.IsZero() || p.Name == "" {
 		return false
 	}
 
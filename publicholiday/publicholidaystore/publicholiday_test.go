@@ -10,6 +10,7 @@ import (
 	"github.com/rebel-l/go-utils/testingutils"
 	"github.com/rebel-l/go-utils/uuidutils"
 	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/calendar/calendarmodel"
 	"github.com/rebel-l/ttrack_api/config"
 	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaystore"
 	"os"
@@ -82,37 +83,42 @@ func TestPublicHoliday_Create(t *testing.T) {
 		{
 			name: "publicholiday has id",
 			actual: &publicholidaystore.PublicHoliday{
-				ID:      testingutils.UUIDParse(t, "2d8dc0c0-927f-4e74-adfd-6d803421347a"),
-				Day:     now,
-				Name:    "NSiDl1JyDEC6f7bzAg53csEtj1ZPEyc9ArTmnevJueK7hXnshI0eb6En4znyqtjlcATu7EiDpl6UL3A816mtDDyaNV0LzWDMg4xFJeBHVpNR96UYmRFylw6gYkboFQAo7wSQX13vf9w8lUIgeyB0FvWw1MDwpD7Q5afXgxawvlUAt0kNR6dvg7kF84TFel0pbnM4OS3ITyfZLLB6VlGGRr",
-				HalfDay: true,
+				ID:         testingutils.UUIDParse(t, "2d8dc0c0-927f-4e74-adfd-6d803421347a"),
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
+				Name:       "NSiDl1JyDEC6f7bzAg53csEtj1ZPEyc9ArTmnevJueK7hXnshI0eb6En4znyqtjlcATu7EiDpl6UL3A816mtDDyaNV0LzWDMg4xFJeBHVpNR96UYmRFylw6gYkboFQAo7wSQX13vf9w8lUIgeyB0FvWw1MDwpD7Q5afXgxawvlUAt0kNR6dvg7kF84TFel0pbnM4OS3ITyfZLLB6VlGGRr",
+				HalfDay:    true,
 			},
 			expectedErr: publicholidaystore.ErrIDIsSet,
 		},
 		{
 			name: "publicholiday has all fields set",
 			actual: &publicholidaystore.PublicHoliday{
-				Day:     now,
-				Name:    "SHhpY72xVwW2FMCKSC5X7v6DzSAGoIL3qwNY",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
+				Name:       "SHhpY72xVwW2FMCKSC5X7v6DzSAGoIL3qwNY",
+				HalfDay:    true,
 			},
 			expected: &publicholidaystore.PublicHoliday{
-				Day:     now,
-				Name:    "SHhpY72xVwW2FMCKSC5X7v6DzSAGoIL3qwNY",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
+				Name:       "SHhpY72xVwW2FMCKSC5X7v6DzSAGoIL3qwNY",
+				HalfDay:    true,
 			},
 		},
 		{
 			name: "publicholiday has only mandatory fields set",
 			actual: &publicholidaystore.PublicHoliday{
-				Day:     now,
-				Name:    "iXmVQh4iYmmvHzyy7fydphL5PmGQwxK",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now.Add(24 * time.Hour),
+				Name:       "iXmVQh4iYmmvHzyy7fydphL5PmGQwxK",
+				HalfDay:    true,
 			},
 			expected: &publicholidaystore.PublicHoliday{
-				Day:     now,
-				Name:    "iXmVQh4iYmmvHzyy7fydphL5PmGQwxK",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now.Add(24 * time.Hour),
+				Name:       "iXmVQh4iYmmvHzyy7fydphL5PmGQwxK",
+				HalfDay:    true,
 			},
 		},
 	}
@@ -169,14 +175,16 @@ func TestPublicHoliday_Read(t *testing.T) {
 		{
 			name: "success",
 			prepare: &publicholidaystore.PublicHoliday{
-				Day:     now,
-				Name:    "NxN2kMEwq0HeVa6HHoNNnvFjnsUFzhTHRX5dIr6e1bTwMHVzdOVYWjEYiLdPxYe7euupdWAhE3V4nzD91WasOBPk7mE2xPWwHi8Jk75Me19WPKJrY9NivHp0RaEupSaHCuzn6bKi",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
+				Name:       "NxN2kMEwq0HeVa6HHoNNnvFjnsUFzhTHRX5dIr6e1bTwMHVzdOVYWjEYiLdPxYe7euupdWAhE3V4nzD91WasOBPk7mE2xPWwHi8Jk75Me19WPKJrY9NivHp0RaEupSaHCuzn6bKi",
+				HalfDay:    true,
 			},
 			expected: &publicholidaystore.PublicHoliday{
-				Day:     now,
-				Name:    "NxN2kMEwq0HeVa6HHoNNnvFjnsUFzhTHRX5dIr6e1bTwMHVzdOVYWjEYiLdPxYe7euupdWAhE3V4nzD91WasOBPk7mE2xPWwHi8Jk75Me19WPKJrY9NivHp0RaEupSaHCuzn6bKi",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
+				Name:       "NxN2kMEwq0HeVa6HHoNNnvFjnsUFzhTHRX5dIr6e1bTwMHVzdOVYWjEYiLdPxYe7euupdWAhE3V4nzD91WasOBPk7mE2xPWwHi8Jk75Me19WPKJrY9NivHp0RaEupSaHCuzn6bKi",
+				HalfDay:    true,
 			},
 		},
 		{
@@ -235,6 +243,10 @@ func assertPublicHoliday(t *testing.T, expected, actual *publicholidaystore.Publ
 		t.Errorf("expected ID %s but got %s", expected.ID, actual.ID)
 	}
 
+	if expected.CalendarID != actual.CalendarID {
+		t.Errorf("expected CalendarID %s but got %s", expected.CalendarID, actual.CalendarID)
+	}
+
 	if !expected.Day.Equal(actual.Day) {
 		t.Errorf("expected Day %q but got %q", expected.Day, actual.Day)
 	}
@@ -289,56 +301,64 @@ func TestPublicHoliday_Update(t *testing.T) {
 		{
 			name: "publicholiday has no id",
 			actual: &publicholidaystore.PublicHoliday{
-				Day:     now,
-				Name:    "MGTPn1zeHf7molyKR1OCqbVp3EXQxr3wn4uwKNQMyw2HTIsHD1F7Oz9P0FSjsb6RFPXTpSGiEiWj7piGebFna1ldYD03KhtzePbtAHvLtEY1Kk1oM9T3fenOYR2AqX8QoBpzfjtJn7FwL1KSyX4bxCqZZhgXnZtLbswlHIM5cjYphNRoZG6oI7i67tmPEC7xgW5dw4CQK",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
+				Name:       "MGTPn1zeHf7molyKR1OCqbVp3EXQxr3wn4uwKNQMyw2HTIsHD1F7Oz9P0FSjsb6RFPXTpSGiEiWj7piGebFna1ldYD03KhtzePbtAHvLtEY1Kk1oM9T3fenOYR2AqX8QoBpzfjtJn7FwL1KSyX4bxCqZZhgXnZtLbswlHIM5cjYphNRoZG6oI7i67tmPEC7xgW5dw4CQK",
+				HalfDay:    true,
 			},
 			expectedErr: publicholidaystore.ErrIDMissing,
 		},
 		{
 			name: "not existing",
 			actual: &publicholidaystore.PublicHoliday{
-				ID:      testingutils.UUIDParse(t, "7051ce69-9979-4a26-94bf-bc621f71568a"),
-				Day:     now,
-				Name:    "kICyMuQlHelHORC8PyGDXbfcuHQxhi8hOx55LnNLscfBbOjPxygBQUZ",
-				HalfDay: true,
+				ID:         testingutils.UUIDParse(t, "7051ce69-9979-4a26-94bf-bc621f71568a"),
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
+				Name:       "kICyMuQlHelHORC8PyGDXbfcuHQxhi8hOx55LnNLscfBbOjPxygBQUZ",
+				HalfDay:    true,
 			},
 			expectedErr: sql.ErrNoRows,
 		},
 		{
 			name: "publicholiday has all fields set",
 			actual: &publicholidaystore.PublicHoliday{
-				Day:     now.Add(24 * time.Hour),
-				Name:    "RkghL3fvOugDXccRCgjvlvNuesN2YaAAiwI1e77jdSN1IvLVaVnYBYM9YXaQghNWArZEbjPgl81M1JpEqDtsNTTtTaHxoXsqWbqbyHwE7x70mw7xMH4iMgNAE9Tk2avm8PXJ7V5U4WDL",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now.Add(24 * time.Hour),
+				Name:       "RkghL3fvOugDXccRCgjvlvNuesN2YaAAiwI1e77jdSN1IvLVaVnYBYM9YXaQghNWArZEbjPgl81M1JpEqDtsNTTtTaHxoXsqWbqbyHwE7x70mw7xMH4iMgNAE9Tk2avm8PXJ7V5U4WDL",
+				HalfDay:    true,
 			},
 			prepare: &publicholidaystore.PublicHoliday{
-				Day:     now,
-				Name:    "cuRrx4qRsVySGtaariYLvkxN48Fn5tlTwdAaaTexMdtLlaE",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
+				Name:       "cuRrx4qRsVySGtaariYLvkxN48Fn5tlTwdAaaTexMdtLlaE",
+				HalfDay:    true,
 			},
 			expected: &publicholidaystore.PublicHoliday{
-				Day:     now.Add(24 * time.Hour),
-				Name:    "RkghL3fvOugDXccRCgjvlvNuesN2YaAAiwI1e77jdSN1IvLVaVnYBYM9YXaQghNWArZEbjPgl81M1JpEqDtsNTTtTaHxoXsqWbqbyHwE7x70mw7xMH4iMgNAE9Tk2avm8PXJ7V5U4WDL",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now.Add(24 * time.Hour),
+				Name:       "RkghL3fvOugDXccRCgjvlvNuesN2YaAAiwI1e77jdSN1IvLVaVnYBYM9YXaQghNWArZEbjPgl81M1JpEqDtsNTTtTaHxoXsqWbqbyHwE7x70mw7xMH4iMgNAE9Tk2avm8PXJ7V5U4WDL",
+				HalfDay:    true,
 			},
 		},
 		{
 			name: "publicholiday has only mandatory fields set",
 			actual: &publicholidaystore.PublicHoliday{
-				Day:     now.Add(48 * time.Hour),
-				Name:    "TezPamk3B3hDQem0ydTmbmDAWJ4Mqovn0ndhSP0W9wOvx1UBWbgexF69h8pW76l0gubYoZFWXVwJ25JtuAfObJ49NgZ8tYiUtxwuPTxlF3XEOpnVBENtIWUxgQ8DOAj",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now.Add(48 * time.Hour),
+				Name:       "TezPamk3B3hDQem0ydTmbmDAWJ4Mqovn0ndhSP0W9wOvx1UBWbgexF69h8pW76l0gubYoZFWXVwJ25JtuAfObJ49NgZ8tYiUtxwuPTxlF3XEOpnVBENtIWUxgQ8DOAj",
+				HalfDay:    true,
 			},
 			prepare: &publicholidaystore.PublicHoliday{
-				Day:     now,
-				Name:    "C67LgXWkQbJlpIiLfFyDfD6cUnvk0qLTv7XM0clirOun7BI1u3TWGkrIOQns4zi3mCqq3uBgeM6A3l5GOK0sQ6oOVLjeI5zWf6WijwfuXQH6bcaAKfxIYaWbGIOnjEZ5v68YNMYllPegXXnk9oddWzjengOr5CIeVoulJKMKcOdcLav317286QORcsKWLtOfRWWD2p0bsQXUu6p2Ii1mxW431F3dBQtqy57FK35OgGPQOQMETse",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now.Add(-24 * time.Hour),
+				Name:       "C67LgXWkQbJlpIiLfFyDfD6cUnvk0qLTv7XM0clirOun7BI1u3TWGkrIOQns4zi3mCqq3uBgeM6A3l5GOK0sQ6oOVLjeI5zWf6WijwfuXQH6bcaAKfxIYaWbGIOnjEZ5v68YNMYllPegXXnk9oddWzjengOr5CIeVoulJKMKcOdcLav317286QORcsKWLtOfRWWD2p0bsQXUu6p2Ii1mxW431F3dBQtqy57FK35OgGPQOQMETse",
+				HalfDay:    true,
 			},
 			expected: &publicholidaystore.PublicHoliday{
-				Day:     now.Add(48 * time.Hour),
-				Name:    "TezPamk3B3hDQem0ydTmbmDAWJ4Mqovn0ndhSP0W9wOvx1UBWbgexF69h8pW76l0gubYoZFWXVwJ25JtuAfObJ49NgZ8tYiUtxwuPTxlF3XEOpnVBENtIWUxgQ8DOAj",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now.Add(48 * time.Hour),
+				Name:       "TezPamk3B3hDQem0ydTmbmDAWJ4Mqovn0ndhSP0W9wOvx1UBWbgexF69h8pW76l0gubYoZFWXVwJ25JtuAfObJ49NgZ8tYiUtxwuPTxlF3XEOpnVBENtIWUxgQ8DOAj",
+				HalfDay:    true,
 			},
 		},
 	}
@@ -418,9 +438,10 @@ func TestPublicHoliday_Delete(t *testing.T) {
 		{
 			name: "success",
 			prepare: &publicholidaystore.PublicHoliday{
-				Day:     now,
-				Name:    "0kNw8R0tTxLCW4yxO3DcEK3w7gSB6mHRuw1q7ysmxEcdP0OuqA5XkuFoKOMT2urk8CDsDLMjfPk4t4scuwWRaJ0XuMfs45479lboXdPKQJZgfx21Moqwlr727v2cAGhNmVH8IVIIBz5kP89b08Umlldoi1pb64RCdwA",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
+				Name:       "0kNw8R0tTxLCW4yxO3DcEK3w7gSB6mHRuw1q7ysmxEcdP0OuqA5XkuFoKOMT2urk8CDsDLMjfPk4t4scuwWRaJ0XuMfs45479lboXdPKQJZgfx21Moqwlr727v2cAGhNmVH8IVIIBz5kP89b08Umlldoi1pb64RCdwA",
+				HalfDay:    true,
 			},
 		},
 		{
@@ -428,6 +449,7 @@ func TestPublicHoliday_Delete(t *testing.T) {
 			prepare: &publicholidaystore.PublicHoliday{
 				ID: testingutils.UUIDParse(t, "b42e6b6b-2ea6-46af-b8e7-273b78626dd4"),
 			},
+			expectedErr: sql.ErrNoRows,
 		},
 	}
 
@@ -487,7 +509,8 @@ func TestPublicHoliday_IsValid(t *testing.T) {
 		{
 			name: "publicholiday has day only",
 			actual: &publicholidaystore.PublicHoliday{
-				Day: now,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
 			},
 			expected: false,
 		},
@@ -508,38 +531,50 @@ func TestPublicHoliday_IsValid(t *testing.T) {
 		{
 			name: "mandatory fields only",
 			actual: &publicholidaystore.PublicHoliday{
-				Day:     now,
-				Name:    "Wp1vfWIIE1Lwg0zun5Ocf5PSZ3RlyB9bIEsQhsxIshDI6PFOIJ5lWgQYBApLYRkYhFUXT4MoXoaQ1HJ0gISWb2lSKbm8OMP3pBS4hSsefkYhKwD2VtZo18os6aLoL8BmrvZhL26Ot5GbCPRuVa",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
+				Name:       "Wp1vfWIIE1Lwg0zun5Ocf5PSZ3RlyB9bIEsQhsxIshDI6PFOIJ5lWgQYBApLYRkYhFUXT4MoXoaQ1HJ0gISWb2lSKbm8OMP3pBS4hSsefkYhKwD2VtZo18os6aLoL8BmrvZhL26Ot5GbCPRuVa",
+				HalfDay:    true,
 			},
 			expected: true,
 		},
+		{
+			name: "calendar missing",
+			actual: &publicholidaystore.PublicHoliday{
+				Day:  now,
+				Name: "Tag der Arbeit",
+			},
+			expected: false,
+		},
 		{
 			name: "mandatory fields with id",
 			actual: &publicholidaystore.PublicHoliday{
-				ID:      testingutils.UUIDParse(t, "da5d2f18-448d-4ef4-bf7a-13d6dc84cb39"),
-				Day:     now,
-				Name:    "uKLengFnJmFZ0S3hyreNv9NRP9kSc9QLXxEnURmboJYxG07HyUZ9uJpePkH4oJWzroZpXeI0hAuE0MKGgHE9KuCvign5zqVEnpSqWHZFlowm2FusNmKPHinsekmN568EeOohr81NDpZRKkNCT9fJ9i4tyMaHk77aqIgmvAOABN432vwwr2zbNTYnWOfjip1m8XauL1ahjQZHCqIRiXh08s1Lift971",
-				HalfDay: true,
+				ID:         testingutils.UUIDParse(t, "da5d2f18-448d-4ef4-bf7a-13d6dc84cb39"),
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
+				Name:       "uKLengFnJmFZ0S3hyreNv9NRP9kSc9QLXxEnURmboJYxG07HyUZ9uJpePkH4oJWzroZpXeI0hAuE0MKGgHE9KuCvign5zqVEnpSqWHZFlowm2FusNmKPHinsekmN568EeOohr81NDpZRKkNCT9fJ9i4tyMaHk77aqIgmvAOABN432vwwr2zbNTYnWOfjip1m8XauL1ahjQZHCqIRiXh08s1Lift971",
+				HalfDay:    true,
 			},
 			expected: true,
 		},
 		{
 			name: "all fields",
 			actual: &publicholidaystore.PublicHoliday{
-				ID:      testingutils.UUIDParse(t, "da6ce09f-5801-412a-a412-f4932945f3eb"),
-				Day:     now,
-				Name:    "rICgelUkOtOwLM8J6XizrfIwZLKOLY44wlO7N32cFNPTYNptbHdUlorRFCfju1CertznomUS",
-				HalfDay: true,
+				ID:         testingutils.UUIDParse(t, "da6ce09f-5801-412a-a412-f4932945f3eb"),
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
+				Name:       "rICgelUkOtOwLM8J6XizrfIwZLKOLY44wlO7N32cFNPTYNptbHdUlorRFCfju1CertznomUS",
+				HalfDay:    true,
 			},
 			expected: true,
 		},
 		{
 			name: "all fields without id",
 			actual: &publicholidaystore.PublicHoliday{
-				Day:     now,
-				Name:    "kiE0rxzncioLMHhRFbGiX2aKSOMm3p6cGQ5S",
-				HalfDay: true,
+				CalendarID: calendarmodel.DefaultID,
+				Day:        now,
+				Name:       "kiE0rxzncioLMHhRFbGiX2aKSOMm3p6cGQ5S",
+				HalfDay:    true,
 			},
 			expected: true,
 		},
@@ -0,0 +1,125 @@
+package reportmodel
+
+import (
+	"time"
+
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+	"github.com/rebel-l/ttrack_api/schedule/schedulemodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+// Balance represents the overtime balance of a year. Target hours are taken from the work schedules, public holidays
+// have no target hours, half-day public holidays half of them. Actual hours are the net worked time of all timelogs.
+// All values are in hours.
+type Balance struct {
+	Year        int            `json:"Year"`
+	FirstDay    time.Time      `json:"FirstDay"`
+	LastDay     time.Time      `json:"LastDay"`
+	TargetHours float64        `json:"TargetHours"`
+	ActualHours float64        `json:"ActualHours"`
+	Balance     float64        `json:"Balance"`
+	Weeks       []*WeekBalance `json:"Weeks"`
+}
+
+// WeekBalance represents the overtime balance of an ISO week. Cumulative is the balance of the year up to the end of
+// this week. The first and last week of a year only cover the days belonging to the year.
+type WeekBalance struct {
+	Year        int       `json:"Year"`
+	Week        int       `json:"Week"`
+	FirstDay    time.Time `json:"FirstDay"`
+	LastDay     time.Time `json:"LastDay"`
+	TargetHours float64   `json:"TargetHours"`
+	ActualHours float64   `json:"ActualHours"`
+	Balance     float64   `json:"Balance"`
+	Cumulative  float64   `json:"Cumulative"`
+}
+
+// NewBalance returns you a Balance struct initialized by a given year. Based on the year it calculates first and last
+// day of the year. The last day can be changed to calculate the balance up to a certain day, e.g. today.
+func NewBalance(year int) *Balance {
+	return &Balance{
+		Year:     year,
+		FirstDay: time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
+		LastDay:  time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second),
+		Weeks:    make([]*WeekBalance, 0),
+	}
+}
+
+// Calculate fills all values for the balance from FirstDay to LastDay.
+func (b *Balance) Calculate(
+	schedules schedulemodel.Schedules,
+	publicHolidays publicholidaymodel.PublicHolidays,
+	timelogs timelogmodel.Timelogs,
+) error {
+	holidays := daysOff(publicHolidays)
+
+	actual := make(map[string]time.Duration) // key = day
+	eachWorkedTime(timelogs, func(accounted *timelogmodel.Timelog, gross, brk time.Duration) {
+		actual[accounted.Start.Format(time.DateOnly)] += gross - brk
+	})
+
+	var (
+		week                     *WeekBalance
+		weekTarget, weekActual   time.Duration
+		totalTarget, totalActual time.Duration
+	)
+
+	closeWeek := func() {
+		if week == nil {
+			return
+		}
+
+		week.TargetHours = hours(weekTarget)
+		week.ActualHours = hours(weekActual)
+		week.Balance = hours(weekActual - weekTarget)
+		week.Cumulative = hours(totalActual - totalTarget)
+		b.Weeks = append(b.Weeks, week)
+	}
+
+	for day := b.FirstDay; day.Before(b.LastDay); day = day.Add(oneDay) {
+		year, number := day.ISOWeek()
+		if week == nil || week.Year != year || week.Week != number {
+			closeWeek()
+
+			week = &WeekBalance{Year: year, Week: number, FirstDay: day} // nolint: exhaustivestruct
+			weekTarget, weekActual = 0, 0
+		}
+
+		keyDay := day.Format(time.DateOnly)
+		target := time.Duration(float64(targetOfDay(schedules, day)) * (1 - holidays[keyDay]))
+
+		weekTarget += target
+		weekActual += actual[keyDay]
+		totalTarget += target
+		totalActual += actual[keyDay]
+		week.LastDay = day
+	}
+
+	closeWeek()
+
+	b.TargetHours = hours(totalTarget)
+	b.ActualHours = hours(totalActual)
+	b.Balance = hours(totalActual - totalTarget)
+
+	return nil
+}
+
+// daysOff returns the fraction of the day which is off per public holiday. The key is the day.
+func daysOff(publicHolidays publicholidaymodel.PublicHolidays) map[string]float64 {
+	days := make(map[string]float64)
+	for _, v := range publicHolidays {
+		days[v.Day.Format(time.DateOnly)] = v.DaysOff()
+	}
+
+	return days
+}
+
+// targetOfDay returns the target time of the given day by the schedule valid on this day.
+func targetOfDay(schedules schedulemodel.Schedules, day time.Time) time.Duration {
+	schedule := schedules.For(day)
+	if schedule == nil {
+		return 0
+	}
+
+	return time.Duration(schedule.TargetHours(day.Weekday()) * float64(time.Hour))
+}
@@ -0,0 +1,103 @@
+package reportmodel_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/schedule/schedulemodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+func TestBalance_Calculate(t *testing.T) {
+	t.Parallel()
+
+	schedules := schedulemodel.Schedules{
+		{ValidFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Monday: 8, Tuesday: 8, Wednesday: 8, Thursday: 8, Friday: 8},
+		{ValidFrom: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), Monday: 6, Tuesday: 6, Wednesday: 6, Thursday: 6, Friday: 6},
+	}
+
+	publicHolidays := publicholidaymodel.PublicHolidays{
+		{Day: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	timelog := func(day, startHour, stopHour int, reason string) *timelogmodel.Timelog {
+		stop := time.Date(2024, 1, day, stopHour, 0, 0, 0, time.UTC)
+
+		return &timelogmodel.Timelog{
+			Start:    time.Date(2024, 1, day, startHour, 0, 0, 0, time.UTC),
+			Stop:     &stop,
+			Reason:   reason,
+			Location: timelogmodel.LocationHome,
+		}
+	}
+
+	timelogs := timelogmodel.Timelogs{
+		timelog(2, 8, 18, timelogmodel.ReasonWork),
+		timelog(2, 12, 13, timelogmodel.ReasonBreak),
+		timelog(3, 8, 16, timelogmodel.ReasonWork),
+		timelog(6, 10, 12, timelogmodel.ReasonWork),
+		timelog(10, 8, 12, timelogmodel.ReasonWork),
+	}
+
+	balance := reportmodel.NewBalance(2024)
+	balance.LastDay = time.Date(2024, 1, 10, 23, 59, 59, 0, time.UTC)
+
+	if err := balance.Calculate(schedules, publicHolidays, timelogs); err != nil {
+		t.Fatalf("Calculate error: %s", err)
+	}
+
+	expectedWeeks := []reportmodel.WeekBalance{
+		{
+			Year: 2024, Week: 1,
+			FirstDay: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), LastDay: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+			TargetHours: 32, ActualHours: 19, Balance: -13, Cumulative: -13,
+		},
+		{
+			Year: 2024, Week: 2,
+			FirstDay: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), LastDay: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+			TargetHours: 22, ActualHours: 4, Balance: -18, Cumulative: -31,
+		},
+	}
+
+	if len(balance.Weeks) != len(expectedWeeks) {
+		t.Fatalf("expected %d weeks but got %d", len(expectedWeeks), len(balance.Weeks))
+	}
+
+	for i, expected := range expectedWeeks {
+		if *balance.Weeks[i] != expected {
+			t.Errorf("week %d expected %+v, got %+v", i, expected, *balance.Weeks[i])
+		}
+	}
+
+	if balance.TargetHours != 54 || balance.ActualHours != 23 || balance.Balance != -31 {
+		t.Errorf("expected target 54, actual 23 and balance -31 but got %v, %v and %v",
+			balance.TargetHours, balance.ActualHours, balance.Balance)
+	}
+}
+
+func TestBalance_CalculateHalfDayPublicHoliday(t *testing.T) {
+	t.Parallel()
+
+	schedules := schedulemodel.Schedules{
+		{ValidFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Monday: 8, Tuesday: 8, Wednesday: 8, Thursday: 8, Friday: 8},
+	}
+
+	publicHolidays := publicholidaymodel.PublicHolidays{
+		{Day: time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC), HalfDay: true},
+		{Day: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)},
+	}
+
+	balance := reportmodel.NewBalance(2024)
+	balance.FirstDay = time.Date(2024, 12, 23, 0, 0, 0, 0, time.UTC)
+	balance.LastDay = time.Date(2024, 12, 25, 23, 59, 59, 0, time.UTC)
+
+	if err := balance.Calculate(schedules, publicHolidays, nil); err != nil {
+		t.Fatalf("Calculate error: %s", err)
+	}
+
+	if balance.TargetHours != 12 {
+		t.Errorf("TargetHours expected 12, got %v", balance.TargetHours)
+	}
+}
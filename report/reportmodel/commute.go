@@ -0,0 +1,156 @@
+package reportmodel
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rebel-l/ttrack_api/commute/commutemodel"
+	"github.com/rebel-l/ttrack_api/tax/taxmodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+// Commute represents the commute to the offices of a year. Each day with work in an office counts as one commute to
+// the office of its first office timelog, or to the default office if the timelog has none. Kilometres are the round
+// trip distances. TaxDeduction is based on the one-way distance, Reimbursement on the kilometres driven.
+type Commute struct {
+	Year          int                 `json:"Year"`
+	FirstDay      time.Time           `json:"FirstDay"`
+	LastDay       time.Time           `json:"LastDay"`
+	Days          int                 `json:"Days"`
+	Kilometres    float64             `json:"Kilometres"`
+	TaxDeduction  float64             `json:"TaxDeduction"`
+	Reimbursement float64             `json:"Reimbursement"`
+	Offices       []*CommuteOffice    `json:"Offices"`
+	Warnings      map[string][]string `json:"Warnings"`
+}
+
+// CommuteOffice represents the commute to a single office of a year.
+type CommuteOffice struct {
+	OfficeID      uuid.UUID `json:"OfficeID"`
+	Name          string    `json:"Name"`
+	Distance      float64   `json:"Distance"`
+	Days          int       `json:"Days"`
+	Kilometres    float64   `json:"Kilometres"`
+	TaxDeduction  float64   `json:"TaxDeduction"`
+	Reimbursement float64   `json:"Reimbursement"`
+}
+
+// NewCommute returns you a Commute struct initialized by a given year. Based on the year it calculates first and last
+// day of the year.
+func NewCommute(year int) *Commute {
+	return &Commute{
+		Year:     year,
+		FirstDay: time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
+		LastDay:  time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second),
+		Offices:  make([]*CommuteOffice, 0),
+		Warnings: make(map[string][]string),
+	}
+}
+
+// Calculate counts the office days per office and the resulting kilometres and amounts based on the tax setting.
+// Office days which can't be assigned to a known office are reported as warning. Timelogs outside of the year or
+// without stop time are ignored.
+func (c *Commute) Calculate(
+	setting *taxmodel.Setting,
+	offices commutemodel.Offices,
+	timelogs timelogmodel.Timelogs,
+) error {
+	if setting == nil {
+		setting = taxmodel.NewSetting(c.Year)
+	}
+
+	var defaultOffice *commutemodel.Office
+
+	known := make(map[uuid.UUID]*commutemodel.Office)
+
+	for _, office := range offices {
+		known[office.ID] = office
+
+		if office.Default {
+			defaultOffice = office
+		}
+	}
+
+	perOffice := make(map[uuid.UUID]*CommuteOffice)
+
+	for keyDay, timelog := range c.officeDays(timelogs) {
+		office := defaultOffice
+		if timelog.OfficeID != nil {
+			office = known[*timelog.OfficeID]
+		}
+
+		if office == nil {
+			c.Warnings[keyDay] = append(c.Warnings[keyDay], "no office to commute to")
+
+			continue
+		}
+
+		if _, ok := perOffice[office.ID]; !ok {
+			perOffice[office.ID] = &CommuteOffice{OfficeID: office.ID, Name: office.Name, Distance: office.Distance}
+		}
+
+		perOffice[office.ID].Days++
+	}
+
+	for _, v := range perOffice {
+		v.Kilometres = 2 * v.Distance * float64(v.Days) // nolint: gomnd
+		v.TaxDeduction = amount(float64(v.Days) * deductionPerDay(setting, v.Distance))
+		v.Reimbursement = amount(v.Kilometres * setting.ReimbursementRate)
+
+		c.Days += v.Days
+		c.Kilometres += v.Kilometres
+		c.TaxDeduction += v.TaxDeduction
+		c.Reimbursement += v.Reimbursement
+		c.Offices = append(c.Offices, v)
+	}
+
+	sort.Slice(c.Offices, func(i, j int) bool {
+		return c.Offices[i].Name < c.Offices[j].Name
+	})
+
+	c.TaxDeduction = amount(c.TaxDeduction)
+	c.Reimbursement = amount(c.Reimbursement)
+
+	return nil
+}
+
+// officeDays returns the first finished office timelog with reason work for each day of the year.
+func (c *Commute) officeDays(timelogs timelogmodel.Timelogs) map[string]*timelogmodel.Timelog {
+	days := make(map[string]*timelogmodel.Timelog) // key = day
+
+	for _, timelog := range timelogs {
+		if timelog.Reason != timelogmodel.ReasonWork || timelog.Location != timelogmodel.LocationOffice {
+			continue
+		}
+
+		if timelog.Stop == nil || timelog.Stop.IsZero() {
+			continue
+		}
+
+		if timelog.Start.Before(c.FirstDay) || timelog.Start.After(c.LastDay) {
+			continue
+		}
+
+		keyDay := timelog.Start.Format(time.DateOnly)
+		if first, ok := days[keyDay]; !ok || timelog.Start.Before(first.Start) {
+			days[keyDay] = timelog
+		}
+	}
+
+	return days
+}
+
+// deductionPerDay returns the tax deduction for a single commute over the one-way distance. Kilometres beyond
+// CommuteLongDistanceFrom are deducted by the long distance rate.
+func deductionPerDay(setting *taxmodel.Setting, distance float64) float64 {
+	short := math.Min(distance, setting.CommuteLongDistanceFrom)
+
+	return short*setting.CommuteRate + (distance-short)*setting.CommuteRateLongDistance
+}
+
+// amount rounds the value to cents.
+func amount(v float64) float64 {
+	return math.Round(v*100) / 100 // nolint: gomnd
+}
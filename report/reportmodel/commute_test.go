@@ -0,0 +1,118 @@
+package reportmodel_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rebel-l/ttrack_api/commute/commutemodel"
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/tax/taxmodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+func TestCommute_Calculate(t *testing.T) {
+	t.Parallel()
+
+	near := &commutemodel.Office{ID: uuid.New(), Name: "Berlin", Distance: 10, Default: true}
+	far := &commutemodel.Office{ID: uuid.New(), Name: "Potsdam", Distance: 30}
+	unknown := uuid.New()
+
+	timelog := func(day, hour int, location string, office *uuid.UUID, finished bool) *timelogmodel.Timelog {
+		start := time.Date(2024, 3, day, hour, 0, 0, 0, time.UTC)
+		stop := start.Add(2 * time.Hour)
+
+		tl := &timelogmodel.Timelog{
+			Start:    start,
+			Reason:   timelogmodel.ReasonWork,
+			Location: location,
+			OfficeID: office,
+		}
+		if finished {
+			tl.Stop = &stop
+		}
+
+		return tl
+	}
+
+	timelogs := timelogmodel.Timelogs{
+		// office day without office, the default office is used
+		timelog(4, 8, timelogmodel.LocationOffice, nil, true),
+		// office day with two offices, the first one counts
+		timelog(5, 14, timelogmodel.LocationOffice, &near.ID, true),
+		timelog(5, 8, timelogmodel.LocationOffice, &far.ID, true),
+		// not counted: home day, running timelog
+		timelog(6, 8, timelogmodel.LocationHome, nil, true),
+		timelog(8, 8, timelogmodel.LocationOffice, nil, false),
+		// office day with an office which doesn't exist anymore
+		timelog(7, 8, timelogmodel.LocationOffice, &unknown, true),
+	}
+
+	setting := taxmodel.NewSetting(2024)
+
+	testCases := []struct {
+		name          string
+		offices       commutemodel.Offices
+		days          int
+		kilometres    float64
+		taxDeduction  float64
+		reimbursement float64
+		warnings      int
+	}{
+		{
+			name:          "with default office",
+			offices:       commutemodel.Offices{near, far},
+			days:          2,
+			kilometres:    80,
+			taxDeduction:  12.8, // 10 * 0.3 + (20 * 0.3 + 10 * 0.38)
+			reimbursement: 24,
+			warnings:      1,
+		},
+		{
+			name:          "without default office",
+			offices:       commutemodel.Offices{far},
+			days:          1,
+			kilometres:    60,
+			taxDeduction:  9.8,
+			reimbursement: 18,
+			warnings:      2,
+		},
+		{
+			name:     "no offices",
+			warnings: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			report := reportmodel.NewCommute(2024)
+			if err := report.Calculate(setting, testCase.offices, timelogs); err != nil {
+				t.Fatalf("expected no error but got '%v'", err)
+			}
+
+			if report.Days != testCase.days {
+				t.Errorf("expected %d days but got %d", testCase.days, report.Days)
+			}
+
+			if report.Kilometres != testCase.kilometres {
+				t.Errorf("expected %f kilometres but got %f", testCase.kilometres, report.Kilometres)
+			}
+
+			if report.TaxDeduction != testCase.taxDeduction {
+				t.Errorf("expected tax deduction %f but got %f", testCase.taxDeduction, report.TaxDeduction)
+			}
+
+			if report.Reimbursement != testCase.reimbursement {
+				t.Errorf("expected reimbursement %f but got %f", testCase.reimbursement, report.Reimbursement)
+			}
+
+			if len(report.Warnings) != testCase.warnings {
+				t.Errorf("expected %d warnings but got %d: %v", testCase.warnings, len(report.Warnings), report.Warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package reportmodel
+
+import (
+	"math"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/tax/taxmodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+// HomeOffice represents the days relevant for the home office deduction of the tax return. Only work timelogs count:
+// a day with work at home only is a home day, a day with work in the office only an office day and a day with both is
+// a mixed day. Only home days are deductible, each with the allowance until the yearly cap is reached.
+type HomeOffice struct {
+	Year       int       `json:"Year"`
+	FirstDay   time.Time `json:"FirstDay"`
+	LastDay    time.Time `json:"LastDay"`
+	HomeDays   int       `json:"HomeDays"`
+	OfficeDays int       `json:"OfficeDays"`
+	MixedDays  int       `json:"MixedDays"`
+	Allowance  float64   `json:"Allowance"`
+	Cap        float64   `json:"Cap"`
+	Amount     float64   `json:"Amount"`
+	Capped     bool      `json:"Capped"`
+}
+
+// NewHomeOffice returns you a HomeOffice struct initialized by a given year. Based on the year it calculates first
+// and last day of the year.
+func NewHomeOffice(year int) *HomeOffice {
+	return &HomeOffice{ // nolint: exhaustivestruct
+		Year:     year,
+		FirstDay: time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
+		LastDay:  time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second),
+	}
+}
+
+// Calculate counts the days per location type and the deductible amount based on the tax setting. Timelogs outside
+// of the year or without stop time are ignored.
+func (h *HomeOffice) Calculate(setting *taxmodel.Setting, timelogs timelogmodel.Timelogs) error {
+	if setting == nil {
+		setting = taxmodel.NewSetting(h.Year)
+	}
+
+	h.Allowance = setting.HomeOfficeAllowance
+	h.Cap = setting.HomeOfficeCap
+
+	locations := make(map[string]map[string]bool) // key 1 = day, key 2 = location
+
+	for _, timelog := range timelogs {
+		if timelog.Reason != timelogmodel.ReasonWork || timelog.Stop == nil || timelog.Stop.IsZero() {
+			continue
+		}
+
+		if timelog.Start.Before(h.FirstDay) || timelog.Start.After(h.LastDay) {
+			continue
+		}
+
+		keyDay := timelog.Start.Format(time.DateOnly)
+		if _, ok := locations[keyDay]; !ok {
+			locations[keyDay] = make(map[string]bool)
+		}
+
+		locations[keyDay][timelog.Location] = true
+	}
+
+	for _, v := range locations {
+		switch {
+		case v[timelogmodel.LocationHome] && v[timelogmodel.LocationOffice]:
+			h.MixedDays++
+		case v[timelogmodel.LocationHome]:
+			h.HomeDays++
+		case v[timelogmodel.LocationOffice]:
+			h.OfficeDays++
+		}
+	}
+
+	h.Amount = float64(h.HomeDays) * h.Allowance
+	if h.Amount > h.Cap {
+		h.Amount = h.Cap
+		h.Capped = true
+	}
+
+	h.Amount = math.Round(h.Amount*100) / 100 // nolint: gomnd
+
+	return nil
+}
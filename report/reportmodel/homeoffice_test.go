@@ -0,0 +1,104 @@
+package reportmodel_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/tax/taxmodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+func TestHomeOffice_Calculate(t *testing.T) {
+	t.Parallel()
+
+	timelog := func(day, hour int, reason, location string, finished bool) *timelogmodel.Timelog {
+		start := time.Date(2024, 3, day, hour, 0, 0, 0, time.UTC)
+		stop := start.Add(2 * time.Hour)
+
+		tl := &timelogmodel.Timelog{Start: start, Reason: reason, Location: location}
+		if finished {
+			tl.Stop = &stop
+		}
+
+		return tl
+	}
+
+	timelogs := timelogmodel.Timelogs{
+		// home day with a break
+		timelog(4, 8, timelogmodel.ReasonWork, timelogmodel.LocationHome, true),
+		timelog(4, 10, timelogmodel.ReasonBreak, timelogmodel.LocationOffice, true),
+		timelog(4, 12, timelogmodel.ReasonWork, timelogmodel.LocationHome, true),
+		// office day
+		timelog(5, 8, timelogmodel.ReasonWork, timelogmodel.LocationOffice, true),
+		// mixed day, the first location doesn't matter
+		timelog(6, 8, timelogmodel.ReasonWork, timelogmodel.LocationOffice, true),
+		timelog(6, 14, timelogmodel.ReasonWork, timelogmodel.LocationHome, true),
+		// home day
+		timelog(7, 8, timelogmodel.ReasonWork, timelogmodel.LocationHome, true),
+		// not counted: vacation, running timelog, previous year
+		timelog(8, 8, timelogmodel.ReasonVacation, timelogmodel.LocationHome, true),
+		timelog(11, 8, timelogmodel.ReasonWork, timelogmodel.LocationHome, false),
+		{
+			Start:    time.Date(2023, 12, 29, 8, 0, 0, 0, time.UTC),
+			Stop:     timePtr(time.Date(2023, 12, 29, 16, 0, 0, 0, time.UTC)),
+			Reason:   timelogmodel.ReasonWork,
+			Location: timelogmodel.LocationHome,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		setting  *taxmodel.Setting
+		capped   bool
+		expected float64
+	}{
+		{
+			name:     "default setting",
+			setting:  nil,
+			expected: 2 * taxmodel.DefaultHomeOfficeAllowance,
+		},
+		{
+			name:     "below cap",
+			setting:  &taxmodel.Setting{Year: 2024, HomeOfficeAllowance: 4.5, HomeOfficeCap: 100},
+			expected: 9,
+		},
+		{
+			name:     "cap reached",
+			setting:  &taxmodel.Setting{Year: 2024, HomeOfficeAllowance: 6, HomeOfficeCap: 10},
+			capped:   true,
+			expected: 10,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			report := reportmodel.NewHomeOffice(2024)
+			if err := report.Calculate(testCase.setting, timelogs); err != nil {
+				t.Fatalf("expected no error but got '%v'", err)
+			}
+
+			if report.HomeDays != 2 || report.OfficeDays != 1 || report.MixedDays != 1 {
+				t.Errorf(
+					"expected 2 home, 1 office and 1 mixed day but got %d, %d and %d",
+					report.HomeDays, report.OfficeDays, report.MixedDays,
+				)
+			}
+
+			if report.Amount != testCase.expected || report.Capped != testCase.capped {
+				t.Errorf(
+					"expected amount %v, capped %t but got %v, capped %t",
+					testCase.expected, testCase.capped, report.Amount, report.Capped,
+				)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,142 @@
+package reportmodel
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rebel-l/go-utils/uuidutils"
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+const daysPerWeek = 7
+
+var (
+	// ErrInvalidMonth occurs if a month is not between 1 and 12.
+	ErrInvalidMonth = errors.New("month should be between 1 and 12")
+
+	// ErrInvalidWeek occurs if an ISO week doesn't exist in the year.
+	ErrInvalidWeek = errors.New("week doesn't exist in year")
+
+	// ErrInvalidRange occurs if the last day of a range is before its first day.
+	ErrInvalidRange = errors.New("last day should not be before first day")
+)
+
+// NewMonthReport returns you a Report struct initialized by a given month of the year.
+func NewMonthReport(year int, month time.Month) (*Report, error) {
+	if month < time.January || month > time.December {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMonth, month)
+	}
+
+	firstDay := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+
+	r := newReport(year, firstDay, firstDay.AddDate(0, 1, 0).Add(-time.Second))
+	r.Month = int(month)
+
+	return r, nil
+}
+
+// NewWeekReport returns you a Report struct initialized by a given ISO week of the year. The week starts on Monday, so
+// its first or last days can belong to the previous or next year.
+func NewWeekReport(year, week int) (*Report, error) {
+	// the 28th of December is always in the last week of the year
+	if _, weeks := time.Date(year, 12, 28, 0, 0, 0, 0, time.UTC).ISOWeek(); week < 1 || week > weeks {
+		return nil, fmt.Errorf("%w: %d has %d weeks", ErrInvalidWeek, year, weeks)
+	}
+
+	// the 4th of January is always in the first week of the year
+	jan4 := time.Date(year, 1, 4, 0, 0, 0, 0, time.UTC)
+	firstDay := jan4.AddDate(0, 0, (week-1)*daysPerWeek-(int(jan4.Weekday())+6)%daysPerWeek)
+
+	r := newReport(year, firstDay, firstDay.AddDate(0, 0, daysPerWeek).Add(-time.Second))
+	r.Week = week
+
+	return r, nil
+}
+
+// NewRangeReport returns you a Report struct initialized by the days from and to, both included. The time of from and
+// to is ignored. The year of the report is the year of the first day.
+func NewRangeReport(from, to time.Time) (*Report, error) {
+	firstDay := date(from)
+	lastDay := date(to)
+
+	if lastDay.Before(firstDay) {
+		return nil, fmt.Errorf(
+			"%w: %s is before %s", ErrInvalidRange, lastDay.Format(time.DateOnly), firstDay.Format(time.DateOnly),
+		)
+	}
+
+	r := newReport(firstDay.Year(), firstDay, lastDay.AddDate(0, 0, 1).Add(-time.Second))
+	r.withMonths = true
+
+	return r, nil
+}
+
+// calculateMonths adds the reports of all months touched by the report. Months only partly covered are cut to the
+// period of the report.
+func (r *Report) calculateMonths(publicHolidays publicholidaymodel.PublicHolidays, timelogs timelogmodel.Timelogs) error {
+	r.Months = make([]*Report, 0)
+
+	month := time.Date(r.FirstDay.Year(), r.FirstDay.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for ; !month.After(r.LastDay); month = month.AddDate(0, 1, 0) {
+		m, err := NewMonthReport(month.Year(), month.Month())
+		if err != nil {
+			return err
+		}
+
+		if m.FirstDay.Before(r.FirstDay) {
+			m.FirstDay = r.FirstDay
+		}
+
+		if m.LastDay.After(r.LastDay) {
+			m.LastDay = r.LastDay
+		}
+
+		m.Calendar = r.Calendar
+		m.UserID = r.UserID
+
+		if err := m.Calculate(publicHolidays, timelogs); err != nil {
+			return err
+		}
+
+		r.Months = append(r.Months, m)
+	}
+
+	return nil
+}
+
+// inPeriod returns true if the day of the given time is between FirstDay and LastDay.
+func (r *Report) inPeriod(t time.Time) bool {
+	day := date(t)
+
+	return !day.Before(r.FirstDay) && !day.After(r.LastDay)
+}
+
+func (r *Report) filterPublicHolidays(publicHolidays publicholidaymodel.PublicHolidays) publicholidaymodel.PublicHolidays {
+	filtered := publicholidaymodel.PublicHolidays{}
+
+	for _, v := range publicHolidays {
+		if r.inPeriod(v.Day) {
+			filtered = append(filtered, v)
+		}
+	}
+
+	return filtered
+}
+
+func (r *Report) filterTimelogs(timelogs timelogmodel.Timelogs) timelogmodel.Timelogs {
+	filtered := timelogmodel.Timelogs{}
+
+	for _, v := range timelogs {
+		if !uuidutils.IsEmpty(r.UserID) && v.UserID != r.UserID {
+			continue
+		}
+
+		if r.inPeriod(v.Start) {
+			filtered = append(filtered, v)
+		}
+	}
+
+	return filtered
+}
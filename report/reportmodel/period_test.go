@@ -0,0 +1,275 @@
+package reportmodel_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+func TestNewMonthReport(t *testing.T) {
+	t.Parallel()
+
+	report, err := reportmodel.NewMonthReport(2024, time.February)
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if report.Year != 2024 || report.Month != 2 {
+		t.Errorf("expected month 2024-02 but got %d-%02d", report.Year, report.Month)
+	}
+
+	if expected := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC); !report.FirstDay.Equal(expected) {
+		t.Errorf("FirstDay expected %q, got %q", expected, report.FirstDay)
+	}
+
+	if expected := time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC); !report.LastDay.Equal(expected) {
+		t.Errorf("LastDay expected %q, got %q", expected, report.LastDay)
+	}
+
+	for _, month := range []time.Month{0, 13} {
+		if _, err := reportmodel.NewMonthReport(2024, month); !errors.Is(err, reportmodel.ErrInvalidMonth) {
+			t.Errorf("expected error '%v' for month %d but got '%v'", reportmodel.ErrInvalidMonth, month, err)
+		}
+	}
+}
+
+func TestNewWeekReport(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		year        int
+		week        int
+		expected    time.Time
+		expectedErr error
+	}{
+		{
+			name:     "first week starts in previous year",
+			year:     2025,
+			week:     1,
+			expected: time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "first week starts in year",
+			year:     2024,
+			week:     1,
+			expected: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "week in the middle of the year",
+			year:     2024,
+			week:     23,
+			expected: time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "year with 53 weeks",
+			year:     2020,
+			week:     53,
+			expected: time.Date(2020, 12, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "year with 52 weeks",
+			year:        2024,
+			week:        53,
+			expectedErr: reportmodel.ErrInvalidWeek,
+		},
+		{
+			name:        "week zero",
+			year:        2024,
+			expectedErr: reportmodel.ErrInvalidWeek,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			report, err := reportmodel.NewWeekReport(testCase.year, testCase.week)
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !report.FirstDay.Equal(testCase.expected) {
+				t.Errorf("FirstDay expected %q, got %q", testCase.expected, report.FirstDay)
+			}
+
+			if expected := testCase.expected.AddDate(0, 0, 7).Add(-time.Second); !report.LastDay.Equal(expected) {
+				t.Errorf("LastDay expected %q, got %q", expected, report.LastDay)
+			}
+
+			if year, week := report.FirstDay.ISOWeek(); year != testCase.year || week != testCase.week {
+				t.Errorf("expected ISO week %d-%d but got %d-%d", testCase.year, testCase.week, year, week)
+			}
+		})
+	}
+}
+
+func TestReport_CalculatePeriods(t *testing.T) {
+	t.Parallel()
+
+	stop := func(t time.Time) *time.Time {
+		return &t
+	}
+
+	publicHolidays := publicholidaymodel.PublicHolidays{
+		{Day: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Name: "Neujahr"},
+		{Day: time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC), Name: "Karfreitag"},
+		{Day: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), Name: "Ostermontag"},
+	}
+
+	timelogs := timelogmodel.Timelogs{
+		{
+			Start:    time.Date(2024, 3, 28, 8, 0, 0, 0, time.UTC),
+			Stop:     stop(time.Date(2024, 3, 28, 16, 0, 0, 0, time.UTC)),
+			Reason:   timelogmodel.ReasonWork,
+			Location: timelogmodel.LocationOffice,
+		},
+		{
+			Start:    time.Date(2024, 4, 2, 8, 0, 0, 0, time.UTC),
+			Stop:     stop(time.Date(2024, 4, 2, 12, 0, 0, 0, time.UTC)),
+			Reason:   timelogmodel.ReasonWork,
+			Location: timelogmodel.LocationHome,
+		},
+		{
+			Start:    time.Date(2024, 4, 3, 8, 0, 0, 0, time.UTC),
+			Reason:   timelogmodel.ReasonWork,
+			Location: timelogmodel.LocationHome,
+		},
+	}
+
+	// yearly report with months
+	report := reportmodel.NewReport(2024)
+	if err := report.Calculate(publicHolidays, timelogs); err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if len(report.Months) != 12 {
+		t.Fatalf("expected 12 months but got %d", len(report.Months))
+	}
+
+	march, april := report.Months[2], report.Months[3]
+
+	if march.Month != 3 || march.Days != 31 || march.WorkDays != 20 || march.PublicHolidays != 1 {
+		t.Errorf("expected march with 31 days, 20 workdays and 1 public holiday but got %d, %v and %d",
+			march.Days, march.WorkDays, march.PublicHolidays)
+	}
+
+	if march.WorkDaysPerLocation[timelogmodel.LocationOffice] != 1 || march.WorkedTime.Net != 8 {
+		t.Errorf("expected 1 day and 8 hours in the office in march but got %v and %v",
+			march.WorkDaysPerLocation, march.WorkedTime)
+	}
+
+	if april.WorkDaysPerLocation[timelogmodel.LocationHome] != 1 || april.WorkedTime.Net != 4 || len(april.Warnings) != 1 {
+		t.Errorf("expected 1 day, 4 hours at home and a warning in april but got %v, %v and %v",
+			april.WorkDaysPerLocation, april.WorkedTime, april.Warnings)
+	}
+
+	if march.Months != nil {
+		t.Errorf("expected month to have no months but got %d", len(march.Months))
+	}
+
+	// weekly report spanning the end of march
+	week, err := reportmodel.NewWeekReport(2024, 13)
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if err := week.Calculate(publicHolidays, timelogs); err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if week.Days != 7 || week.WorkDays != 4 || week.WorkDaysPerReason[timelogmodel.ReasonWork] != 1 {
+		t.Errorf("expected 7 days, 4 workdays and 1 day of work but got %d, %v and %v",
+			week.Days, week.WorkDays, week.WorkDaysPerReason)
+	}
+}
+
+func TestNewRangeReport(t *testing.T) {
+	t.Parallel()
+
+	if _, err := reportmodel.NewRangeReport(
+		time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+	); !errors.Is(err, reportmodel.ErrInvalidRange) {
+		t.Errorf("expected error '%v' but got '%v'", reportmodel.ErrInvalidRange, err)
+	}
+
+	// fiscal year
+	report, err := reportmodel.NewRangeReport(
+		time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+	)
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if err := report.Calculate(nil, nil); err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if report.Year != 2024 || report.Days != 365 || len(report.Months) != 12 {
+		t.Errorf("expected year 2024 with 365 days and 12 months but got %d, %d and %d",
+			report.Year, report.Days, len(report.Months))
+	}
+
+	if first, last := report.Months[0], report.Months[11]; first.Month != 4 || last.Month != 3 || last.Year != 2025 {
+		t.Errorf("expected months from 2024-04 to 2025-03 but got %d-%02d to %d-%02d",
+			first.Year, first.Month, last.Year, last.Month)
+	}
+}
+
+func TestReport_CalculateRange(t *testing.T) {
+	t.Parallel()
+
+	stop := func(t time.Time) *time.Time {
+		return &t
+	}
+
+	timelog := func(day int) *timelogmodel.Timelog {
+		return &timelogmodel.Timelog{
+			Start:    time.Date(2024, 4, day, 8, 0, 0, 0, time.UTC),
+			Stop:     stop(time.Date(2024, 4, day, 12, 0, 0, 0, time.UTC)),
+			Reason:   timelogmodel.ReasonWork,
+			Location: timelogmodel.LocationHome,
+		}
+	}
+
+	report, err := reportmodel.NewRangeReport(
+		time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC),
+	)
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	publicHolidays := publicholidaymodel.PublicHolidays{
+		{Day: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Name: "outside"},
+		{Day: time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC), Name: "Karfreitag"},
+		{Day: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), Name: "Ostermontag"},
+	}
+
+	if err := report.Calculate(publicHolidays, timelogmodel.Timelogs{timelog(10), timelog(11)}); err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if report.Days != 27 || report.PublicHolidays != 2 || report.WorkedTime.Net != 4 {
+		t.Errorf("expected 27 days, 2 public holidays and 4 hours but got %d, %d and %v",
+			report.Days, report.PublicHolidays, report.WorkedTime.Net)
+	}
+
+	if len(report.Months) != 2 {
+		t.Fatalf("expected 2 months but got %d", len(report.Months))
+	}
+
+	if march, april := report.Months[0], report.Months[1]; march.Days != 17 || april.Days != 10 {
+		t.Errorf("expected months cut to 17 and 10 days but got %d and %d", march.Days, april.Days)
+	}
+}
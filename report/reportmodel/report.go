@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
 	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
 	"golang.org/x/exp/maps"
@@ -14,17 +15,29 @@ const oneDay = 24 * time.Hour
 
 // Report represents all the values to present a proper yearly report of timelogs.
 type Report struct {
-	Year                     int                 `json:"Year"`
-	Days                     int                 `json:"Days"`
-	WorkDays                 int                 `json:"WorkDays"`
-	DaysOnWeekend            int                 `json:"DaysOnWeekend"`
-	PublicHolidays           int                 `json:"PublicHolidays"`
-	PublicHolidaysOnWorkdays int                 `json:"PublicHolidaysOnWorkdays"`
-	FirstDay                 time.Time           `json:"FirstDay"`
-	LastDay                  time.Time           `json:"LastDay"`
-	WorkDaysPerReason        map[string]uint32   `json:"WorkDaysPerReason"`
-	WorkDaysPerLocation      map[string]uint32   `json:"WorkDaysPerLocation"`
-	Warnings                 map[string][]string `json:"Warnings"`
+	UserID                   uuid.UUID                         `json:"UserID"`
+	Year                     int                               `json:"Year"`
+	Month                    int                               `json:"Month,omitempty"`
+	Week                     int                               `json:"Week,omitempty"`
+	Calendar                 string                            `json:"Calendar"`
+	Days                     int                               `json:"Days"`
+	WorkDays                 float64                           `json:"WorkDays"`
+	DaysOnWeekend            int                               `json:"DaysOnWeekend"`
+	PublicHolidays           int                               `json:"PublicHolidays"`
+	PublicHolidaysOnWorkdays float64                           `json:"PublicHolidaysOnWorkdays"`
+	HalfDayPublicHolidays    publicholidaymodel.PublicHolidays `json:"HalfDayPublicHolidays"`
+	FirstDay                 time.Time                         `json:"FirstDay"`
+	LastDay                  time.Time                         `json:"LastDay"`
+	WorkDaysPerReason        map[string]uint32                 `json:"WorkDaysPerReason"`
+	WorkDaysPerLocation      map[string]uint32                 `json:"WorkDaysPerLocation"`
+	WorkDaysPerProject       map[string]uint32                 `json:"WorkDaysPerProject"`
+	WorkDaysPerTag           map[string]uint32                 `json:"WorkDaysPerTag"`
+	WorkedTime               WorkedTime                        `json:"WorkedTime"`
+	WorkedTimePerReason      map[string]WorkedTime             `json:"WorkedTimePerReason"`
+	WorkedTimePerLocation    map[string]WorkedTime             `json:"WorkedTimePerLocation"`
+	Warnings                 map[string][]string               `json:"Warnings"`
+	Months                   []*Report                         `json:"Months,omitempty"`
+	withMonths               bool
 }
 
 type Summary struct {
@@ -45,24 +58,44 @@ func NewReport(year int) *Report {
 	firstDayOfYear := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
 	lastDayOfYear := time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
 
+	r := newReport(year, firstDayOfYear, lastDayOfYear)
+	r.withMonths = true
+
+	return r
+}
+
+func newReport(year int, firstDay, lastDay time.Time) *Report {
 	return &Report{
-		Year:                year,
-		FirstDay:            firstDayOfYear,
-		LastDay:             lastDayOfYear,
-		Days:                0,
-		WorkDays:            0,
-		WorkDaysPerReason:   make(map[string]uint32),
-		WorkDaysPerLocation: make(map[string]uint32),
-		Warnings:            make(map[string][]string),
+		Year:                  year,
+		FirstDay:              firstDay,
+		LastDay:               lastDay,
+		Days:                  0,
+		WorkDays:              0,
+		WorkDaysPerReason:     make(map[string]uint32),
+		WorkDaysPerLocation:   make(map[string]uint32),
+		WorkDaysPerProject:    make(map[string]uint32),
+		WorkDaysPerTag:        make(map[string]uint32),
+		WorkedTimePerReason:   make(map[string]WorkedTime),
+		WorkedTimePerLocation: make(map[string]WorkedTime),
+		Warnings:              make(map[string][]string),
 	}
 }
 
-// Calculate fills all values for the report based on the FirstDay and LastDay.
+// Calculate fills all values for the report based on the FirstDay and LastDay. Public holidays and timelogs outside
+// of this period are ignored, as well as timelogs of other users if the UserID is set. Half-day public holidays count
+// as half a workday. Yearly and range reports contain the reports of all their months.
 func (r *Report) Calculate(publicHolidays publicholidaymodel.PublicHolidays, timelogs timelogmodel.Timelogs) error {
+	publicHolidays = r.filterPublicHolidays(publicHolidays)
+	timelogs = r.filterTimelogs(timelogs)
+
 	r.PublicHolidays = len(publicHolidays)
 	for _, v := range publicHolidays {
+		if v.HalfDay {
+			r.HalfDayPublicHolidays = append(r.HalfDayPublicHolidays, v)
+		}
+
 		if workday(v.Day) {
-			r.PublicHolidaysOnWorkdays++
+			r.PublicHolidaysOnWorkdays += v.DaysOff()
 		}
 	}
 
@@ -82,6 +115,8 @@ func (r *Report) Calculate(publicHolidays publicholidaymodel.PublicHolidays, tim
 
 	workdayReason := make(map[string]map[string]any)   // key 1 = day, key 2 = reason
 	workdayLocation := make(map[string]map[string]any) // key 1 = day, key 2 = location
+	workdayProject := make(map[string]map[string]any)  // key 1 = day, key 2 = project id
+	workdayTag := make(map[string]map[string]any)      // key 1 = day, key 2 = tag
 	for _, timelog := range timelogs {
 		keyDay := timelog.Start.Format(time.DateOnly)
 
@@ -100,9 +135,25 @@ func (r *Report) Calculate(publicHolidays publicholidaymodel.PublicHolidays, tim
 				workdayLocation[keyDay] = make(map[string]any)
 				workdayLocation[keyDay][timelog.Location] = true
 			}
+
+			if timelog.ProjectID != nil {
+				if _, ok := workdayProject[keyDay]; !ok {
+					workdayProject[keyDay] = make(map[string]any)
+				}
+
+				workdayProject[keyDay][timelog.ProjectID.String()] = true
+			}
 		}
 
 		if timelog.Reason != timelogmodel.ReasonBreak {
+			for _, tag := range timelog.Tags {
+				if _, ok := workdayTag[keyDay]; !ok {
+					workdayTag[keyDay] = make(map[string]any)
+				}
+
+				workdayTag[keyDay][tag] = true
+			}
+
 			if _, ok := workdayReason[keyDay]; !ok {
 				workdayReason[keyDay] = make(map[string]any)
 				workdayReason[keyDay][timelog.Reason] = true
@@ -132,6 +183,24 @@ func (r *Report) Calculate(publicHolidays publicholidaymodel.PublicHolidays, tim
 		}
 	}
 
+	for _, projects := range workdayProject {
+		for project := range projects {
+			r.WorkDaysPerProject[project]++
+		}
+	}
+
+	for _, tags := range workdayTag {
+		for tag := range tags {
+			r.WorkDaysPerTag[tag]++
+		}
+	}
+
+	r.calculateWorkedTime(timelogs)
+
+	if r.withMonths {
+		return r.calculateMonths(publicHolidays, timelogs)
+	}
+
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
 	"github.com/rebel-l/ttrack_api/report/reportmodel"
 	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
@@ -38,16 +39,16 @@ func TestReport_Calculate(t *testing.T) {
 		timelogs       timelogmodel.Timelogs
 		expected       struct {
 			PublicHolidays           int
-			PublicHolidaysOnWorkdays int
-			Workdays                 int
+			PublicHolidaysOnWorkdays float64
+			Workdays                 float64
 		}
 	}{
 		{
 			name: "empty public holidays",
 			expected: struct {
 				PublicHolidays           int
-				PublicHolidaysOnWorkdays int
-				Workdays                 int
+				PublicHolidaysOnWorkdays float64
+				Workdays                 float64
 			}{PublicHolidays: 0, PublicHolidaysOnWorkdays: 0, Workdays: 262},
 		},
 		{
@@ -58,10 +59,24 @@ func TestReport_Calculate(t *testing.T) {
 			},
 			expected: struct {
 				PublicHolidays           int
-				PublicHolidaysOnWorkdays int
-				Workdays                 int
+				PublicHolidaysOnWorkdays float64
+				Workdays                 float64
 			}{PublicHolidays: 2, PublicHolidaysOnWorkdays: 1, Workdays: 261},
 		},
+		{
+			name: "with half-day public holidays",
+			publicHolidays: publicholidaymodel.PublicHolidays{
+				{Day: time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC), HalfDay: true}, // workday
+				{Day: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)},                // workday
+				{Day: time.Date(2024, 12, 28, 0, 0, 0, 0, time.UTC), HalfDay: true}, // weekend
+				{Day: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), HalfDay: true}, // workday
+			},
+			expected: struct {
+				PublicHolidays           int
+				PublicHolidaysOnWorkdays float64
+				Workdays                 float64
+			}{PublicHolidays: 4, PublicHolidaysOnWorkdays: 2, Workdays: 260},
+		},
 		// TODO: add test cases with timelogs
 	}
 
@@ -83,7 +98,7 @@ func TestReport_Calculate(t *testing.T) {
 			}
 
 			if report.WorkDays != testCase.expected.Workdays {
-				t.Errorf("WorkDays expected %d, got %d", testCase.expected.Workdays, report.WorkDays)
+				t.Errorf("WorkDays expected %v, got %v", testCase.expected.Workdays, report.WorkDays)
 			}
 
 			if report.DaysOnWeekend != 104 {
@@ -95,7 +110,24 @@ func TestReport_Calculate(t *testing.T) {
 			}
 
 			if report.PublicHolidaysOnWorkdays != testCase.expected.PublicHolidaysOnWorkdays {
-				t.Errorf("PublicHolidaysOnWorkdays expected %d, got %d", testCase.expected.PublicHolidaysOnWorkdays, report.PublicHolidaysOnWorkdays)
+				t.Errorf("PublicHolidaysOnWorkdays expected %v, got %v", testCase.expected.PublicHolidaysOnWorkdays, report.PublicHolidaysOnWorkdays)
+			}
+
+			var expectedHalfDays int
+			for _, v := range testCase.publicHolidays {
+				if v.HalfDay {
+					expectedHalfDays++
+				}
+			}
+
+			if len(report.HalfDayPublicHolidays) != expectedHalfDays {
+				t.Errorf("HalfDayPublicHolidays expected %d, got %d", expectedHalfDays, len(report.HalfDayPublicHolidays))
+			}
+
+			for _, v := range report.HalfDayPublicHolidays {
+				if !v.HalfDay {
+					t.Errorf("HalfDayPublicHolidays expected only half days, got %s", v.Day)
+				}
 			}
 
 			if !report.FirstDay.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
@@ -108,3 +140,228 @@ func TestReport_Calculate(t *testing.T) {
 		})
 	}
 }
+
+func TestReport_CalculateWorkDaysPerProject(t *testing.T) {
+	t.Parallel()
+
+	projectA := uuid.MustParse("0d6d9a58-6bb4-4a4c-a1b1-7f1e8b6c2e54")
+	projectB := uuid.MustParse("3a0b8f5e-2e2e-4a8c-9a8e-7d0c2f3b1a11")
+	timelog := func(day, startHour, stopHour int, projectID *uuid.UUID) *timelogmodel.Timelog {
+		stop := time.Date(2024, 6, day, stopHour, 0, 0, 0, time.UTC)
+
+		return &timelogmodel.Timelog{
+			Start:     time.Date(2024, 6, day, startHour, 0, 0, 0, time.UTC),
+			Stop:      &stop,
+			Reason:    timelogmodel.ReasonWork,
+			Location:  timelogmodel.LocationHome,
+			ProjectID: projectID,
+		}
+	}
+
+	timelogs := timelogmodel.Timelogs{
+		timelog(3, 8, 12, &projectA),
+		timelog(3, 13, 17, &projectA),
+		timelog(4, 8, 12, &projectA),
+		timelog(4, 13, 17, &projectB),
+		timelog(5, 8, 17, nil),
+	}
+
+	report := reportmodel.NewReport(2024)
+	if err := report.Calculate(nil, timelogs); err != nil {
+		t.Fatalf("Calculate error: %s", err)
+	}
+
+	expected := map[string]uint32{projectA.String(): 2, projectB.String(): 1}
+	if len(report.WorkDaysPerProject) != len(expected) {
+		t.Errorf("WorkDaysPerProject expected %v, got %v", expected, report.WorkDaysPerProject)
+	}
+
+	for project, days := range expected {
+		if report.WorkDaysPerProject[project] != days {
+			t.Errorf("WorkDaysPerProject for %s expected %d, got %d", project, days, report.WorkDaysPerProject[project])
+		}
+	}
+}
+
+func TestReport_CalculateWorkDaysPerTag(t *testing.T) {
+	t.Parallel()
+
+	timelog := func(day int, reason string, tags ...string) *timelogmodel.Timelog {
+		stop := time.Date(2024, 6, day, 17, 0, 0, 0, time.UTC)
+
+		return &timelogmodel.Timelog{
+			Start:    time.Date(2024, 6, day, 8, 0, 0, 0, time.UTC),
+			Stop:     &stop,
+			Reason:   reason,
+			Location: timelogmodel.LocationHome,
+			Tags:     tags,
+		}
+	}
+
+	timelogs := timelogmodel.Timelogs{
+		timelog(3, timelogmodel.ReasonWork, "meeting", "oncall"),
+		timelog(4, timelogmodel.ReasonWork, "oncall"),
+		timelog(4, timelogmodel.ReasonBreak, "meeting"),
+		timelog(5, timelogmodel.ReasonWork),
+	}
+
+	report := reportmodel.NewReport(2024)
+	if err := report.Calculate(nil, timelogs); err != nil {
+		t.Fatalf("Calculate error: %s", err)
+	}
+
+	expected := map[string]uint32{"meeting": 1, "oncall": 2}
+	if len(report.WorkDaysPerTag) != len(expected) {
+		t.Errorf("WorkDaysPerTag expected %v, got %v", expected, report.WorkDaysPerTag)
+	}
+
+	for tag, days := range expected {
+		if report.WorkDaysPerTag[tag] != days {
+			t.Errorf("WorkDaysPerTag for %s expected %d, got %d", tag, days, report.WorkDaysPerTag[tag])
+		}
+	}
+}
+
+func TestReport_CalculateByUser(t *testing.T) {
+	t.Parallel()
+
+	userA := uuid.MustParse("5b7e2c1a-9d4f-4e3b-8a6c-1f2e3d4c5b6a")
+	userB := uuid.MustParse("c2d3e4f5-a6b7-4c8d-9e0f-1a2b3c4d5e6f")
+	timelog := func(day int, userID uuid.UUID) *timelogmodel.Timelog {
+		stop := time.Date(2024, 6, day, 17, 0, 0, 0, time.UTC)
+
+		return &timelogmodel.Timelog{
+			UserID:   userID,
+			Start:    time.Date(2024, 6, day, 8, 0, 0, 0, time.UTC),
+			Stop:     &stop,
+			Reason:   timelogmodel.ReasonWork,
+			Location: timelogmodel.LocationHome,
+		}
+	}
+
+	timelogs := timelogmodel.Timelogs{
+		timelog(3, userA),
+		timelog(4, userA),
+		timelog(5, userB),
+	}
+
+	testCases := []struct {
+		name     string
+		userID   uuid.UUID
+		expected uint32
+	}{
+		{
+			name:     "all users",
+			expected: 3,
+		},
+		{
+			name:     "user A",
+			userID:   userA,
+			expected: 2,
+		},
+		{
+			name:     "user B",
+			userID:   userB,
+			expected: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			report := reportmodel.NewReport(2024)
+			report.UserID = testCase.userID
+
+			if err := report.Calculate(nil, timelogs); err != nil {
+				t.Fatalf("Calculate error: %s", err)
+			}
+
+			if report.WorkDaysPerReason[timelogmodel.ReasonWork] != testCase.expected {
+				t.Errorf(
+					"WorkDaysPerReason expected %d, got %d",
+					testCase.expected, report.WorkDaysPerReason[timelogmodel.ReasonWork],
+				)
+			}
+
+			if report.Months[5].WorkDaysPerReason[timelogmodel.ReasonWork] != testCase.expected {
+				t.Errorf(
+					"WorkDaysPerReason of June expected %d, got %d",
+					testCase.expected, report.Months[5].WorkDaysPerReason[timelogmodel.ReasonWork],
+				)
+			}
+		})
+	}
+}
+
+func TestReport_CalculateWorkedTime(t *testing.T) {
+	t.Parallel()
+
+	timelog := func(day, startHour, startMinute, stopHour, stopMinute int, reason, location string) *timelogmodel.Timelog {
+		stop := time.Date(2024, 6, day, stopHour, stopMinute, 0, 0, time.UTC)
+
+		return &timelogmodel.Timelog{
+			Start:    time.Date(2024, 6, day, startHour, startMinute, 0, 0, time.UTC),
+			Stop:     &stop,
+			Reason:   reason,
+			Location: location,
+		}
+	}
+
+	running := timelog(7, 8, 0, 0, 0, timelogmodel.ReasonWork, timelogmodel.LocationHome)
+	running.Stop = nil
+
+	timelogs := timelogmodel.Timelogs{
+		// break between two timelogs
+		timelog(3, 8, 0, 12, 0, timelogmodel.ReasonWork, timelogmodel.LocationOffice),
+		timelog(3, 12, 0, 12, 30, timelogmodel.ReasonBreak, timelogmodel.LocationOffice),
+		timelog(3, 12, 30, 17, 0, timelogmodel.ReasonWork, timelogmodel.LocationOffice),
+		// break inside a timelog
+		timelog(4, 8, 0, 17, 0, timelogmodel.ReasonWork, timelogmodel.LocationHome),
+		timelog(4, 12, 0, 12, 45, timelogmodel.ReasonBreak, timelogmodel.LocationHome),
+		timelog(5, 0, 0, 8, 0, timelogmodel.ReasonSickLeave, timelogmodel.LocationHome),
+		running,
+	}
+
+	report := reportmodel.NewReport(2024)
+	if err := report.Calculate(nil, timelogs); err != nil {
+		t.Fatalf("Calculate error: %s", err)
+	}
+
+	// only work counts into the total, sick leave and breaks outside of work don't
+	expected := reportmodel.WorkedTime{Gross: 17.5, Break: 0.75, Net: 16.75}
+	if report.WorkedTime != expected {
+		t.Errorf("WorkedTime expected %+v, got %+v", expected, report.WorkedTime)
+	}
+
+	expectedPerReason := map[string]reportmodel.WorkedTime{
+		timelogmodel.ReasonWork:      {Gross: 17.5, Break: 0.75, Net: 16.75},
+		timelogmodel.ReasonBreak:     {Gross: 0.5, Break: 0.5, Net: 0},
+		timelogmodel.ReasonSickLeave: {Gross: 8, Break: 0, Net: 8},
+	}
+	if len(report.WorkedTimePerReason) != len(expectedPerReason) {
+		t.Errorf("WorkedTimePerReason expected %v, got %v", expectedPerReason, report.WorkedTimePerReason)
+	}
+
+	for reason, v := range expectedPerReason {
+		if report.WorkedTimePerReason[reason] != v {
+			t.Errorf("WorkedTimePerReason for %s expected %+v, got %+v", reason, v, report.WorkedTimePerReason[reason])
+		}
+	}
+
+	expectedPerLocation := map[string]reportmodel.WorkedTime{
+		timelogmodel.LocationOffice: {Gross: 8.5, Break: 0, Net: 8.5},
+		timelogmodel.LocationHome:   {Gross: 9, Break: 0.75, Net: 8.25},
+	}
+	if len(report.WorkedTimePerLocation) != len(expectedPerLocation) {
+		t.Errorf("WorkedTimePerLocation expected %v, got %v", expectedPerLocation, report.WorkedTimePerLocation)
+	}
+
+	for location, v := range expectedPerLocation {
+		if report.WorkedTimePerLocation[location] != v {
+			t.Errorf("WorkedTimePerLocation for %s expected %+v, got %+v", location, v, report.WorkedTimePerLocation[location])
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package reportmodel
+
+import (
+	"sort"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+// Timesheet represents all days of a month or year with their working times, e.g. to be signed by the employer.
+type Timesheet struct {
+	Year          int                 `json:"Year"`
+	Month         int                 `json:"Month,omitempty"`
+	Calendar      string              `json:"Calendar"`
+	FirstDay      time.Time           `json:"FirstDay"`
+	LastDay       time.Time           `json:"LastDay"`
+	Days          []*TimesheetDay     `json:"Days"`
+	WorkedTime    WorkedTime          `json:"WorkedTime"`
+	DaysPerReason map[string]int      `json:"DaysPerReason"`
+	Warnings      map[string][]string `json:"Warnings"`
+}
+
+// TimesheetDay represents a single day of the timesheet. Start is the earliest start and Stop the latest stop of the
+// finished timelogs of the day. Breaks are not contained in Reasons and Locations. WorkedTime only contains work,
+// other reasons like vacation don't count as worked time.
+type TimesheetDay struct {
+	Day           time.Time  `json:"Day"`
+	Start         *time.Time `json:"Start"`
+	Stop          *time.Time `json:"Stop"`
+	WorkedTime    WorkedTime `json:"WorkedTime"`
+	Reasons       []string   `json:"Reasons"`
+	Locations     []string   `json:"Locations"`
+	PublicHoliday string     `json:"PublicHoliday"`
+	HalfDay       bool       `json:"HalfDay"`
+	Weekend       bool       `json:"Weekend"`
+}
+
+// NewTimesheet returns you a Timesheet struct initialized by a given month of the year.
+func NewTimesheet(year int, month time.Month) (*Timesheet, error) {
+	r, err := NewMonthReport(year, month)
+	if err != nil {
+		return nil, err
+	}
+
+	t := newTimesheet(year, r.FirstDay, r.LastDay)
+	t.Month = int(month)
+
+	return t, nil
+}
+
+// NewYearTimesheet returns you a Timesheet struct containing all days of the given year.
+func NewYearTimesheet(year int) *Timesheet {
+	r := NewReport(year)
+
+	return newTimesheet(year, r.FirstDay, r.LastDay)
+}
+
+func newTimesheet(year int, firstDay, lastDay time.Time) *Timesheet {
+	return &Timesheet{
+		Year:          year,
+		FirstDay:      firstDay,
+		LastDay:       lastDay,
+		Days:          make([]*TimesheetDay, 0),
+		DaysPerReason: make(map[string]int),
+		Warnings:      make(map[string][]string),
+	}
+}
+
+// Calculate fills the days and totals of the timesheet. Public holidays and timelogs outside of the period are ignored.
+func (t *Timesheet) Calculate(publicHolidays publicholidaymodel.PublicHolidays, timelogs timelogmodel.Timelogs) error {
+	days := make(map[string]*TimesheetDay)
+
+	for day := t.FirstDay; day.Before(t.LastDay); day = day.AddDate(0, 0, 1) {
+		d := &TimesheetDay{Day: day, Weekend: !workday(day)}
+		days[day.Format(time.DateOnly)] = d
+		t.Days = append(t.Days, d)
+	}
+
+	for _, v := range publicHolidays {
+		if d, ok := days[v.Day.Format(time.DateOnly)]; ok {
+			d.PublicHoliday = v.Name
+			d.HalfDay = v.HalfDay
+		}
+	}
+
+	worked := make(map[*TimesheetDay]*workedTime)
+	total := &workedTime{}
+
+	eachWorkedTime(timelogs, func(accounted *timelogmodel.Timelog, gross, brk time.Duration) {
+		d, ok := days[accounted.Start.Format(time.DateOnly)]
+		if !ok || !isWork(accounted) {
+			return
+		}
+
+		if _, ok := worked[d]; !ok {
+			worked[d] = &workedTime{}
+		}
+
+		worked[d].gross += gross
+		worked[d].brk += brk
+		total.gross += gross
+		total.brk += brk
+	})
+
+	for _, timelog := range timelogs {
+		keyDay := timelog.Start.Format(time.DateOnly)
+
+		d, ok := days[keyDay]
+		if !ok {
+			continue
+		}
+
+		if timelog.Stop == nil || timelog.Stop.IsZero() {
+			t.Warnings[keyDay] = append(t.Warnings[keyDay], "no stop time")
+
+			continue
+		}
+
+		if d.Start == nil || timelog.Start.Before(*d.Start) {
+			start := timelog.Start
+			d.Start = &start
+		}
+
+		if d.Stop == nil || timelog.Stop.After(*d.Stop) {
+			stop := *timelog.Stop
+			d.Stop = &stop
+		}
+
+		if timelog.Reason != timelogmodel.ReasonBreak {
+			d.Reasons = appendUnique(d.Reasons, timelog.Reason)
+			d.Locations = appendUnique(d.Locations, timelog.Location)
+		}
+	}
+
+	for d, w := range worked {
+		d.WorkedTime = w.toModel()
+	}
+
+	for _, d := range t.Days {
+		for _, reason := range d.Reasons {
+			t.DaysPerReason[reason]++
+		}
+	}
+
+	t.WorkedTime = total.toModel()
+
+	return nil
+}
+
+// appendUnique adds the value to the sorted list if it is not contained yet.
+func appendUnique(list []string, value string) []string {
+	i := sort.SearchStrings(list, value)
+	if i < len(list) && list[i] == value {
+		return list
+	}
+
+	list = append(list, "")
+	copy(list[i+1:], list[i:])
+	list[i] = value
+
+	return list
+}
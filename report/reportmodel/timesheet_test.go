@@ -0,0 +1,134 @@
+package reportmodel_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+func TestNewTimesheet(t *testing.T) {
+	t.Parallel()
+
+	sheet, err := reportmodel.NewTimesheet(2024, time.February)
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if sheet.Year != 2024 || sheet.Month != 2 {
+		t.Errorf("expected month 2024-02 but got %d-%02d", sheet.Year, sheet.Month)
+	}
+
+	if expected := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC); !sheet.FirstDay.Equal(expected) {
+		t.Errorf("FirstDay expected %q, got %q", expected, sheet.FirstDay)
+	}
+
+	if _, err := reportmodel.NewTimesheet(2024, 13); !errors.Is(err, reportmodel.ErrInvalidMonth) {
+		t.Errorf("expected error '%v' but got '%v'", reportmodel.ErrInvalidMonth, err)
+	}
+}
+
+func TestTimesheet_Calculate(t *testing.T) {
+	t.Parallel()
+
+	sheet, err := reportmodel.NewTimesheet(2024, time.March)
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	stop := func(t time.Time) *time.Time {
+		return &t
+	}
+
+	start := time.Date(2024, 3, 4, 8, 0, 0, 0, time.UTC)
+
+	publicHolidays := publicholidaymodel.PublicHolidays{
+		{Day: time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC), Name: "Good Friday"},
+		{Day: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), Name: "Easter Monday"},
+	}
+
+	timelogs := timelogmodel.Timelogs{
+		{Start: start, Stop: stop(start.Add(9 * time.Hour)), Reason: timelogmodel.ReasonWork, Location: timelogmodel.LocationOffice},
+		{Start: start.Add(4 * time.Hour), Stop: stop(start.Add(5 * time.Hour)), Reason: timelogmodel.ReasonBreak, Location: timelogmodel.LocationOffice},
+		{Start: start.Add(10 * time.Hour), Stop: stop(start.Add(11 * time.Hour)), Reason: timelogmodel.ReasonWork, Location: timelogmodel.LocationHome},
+		{Start: start.Add(24 * time.Hour), Stop: stop(start.Add(32 * time.Hour)), Reason: timelogmodel.ReasonVacation, Location: timelogmodel.LocationAbsence},
+		{Start: start.Add(48 * time.Hour), Reason: timelogmodel.ReasonWork, Location: timelogmodel.LocationOffice},
+		{Start: start.AddDate(0, 1, 0), Stop: stop(start.AddDate(0, 1, 0).Add(time.Hour)), Reason: timelogmodel.ReasonWork, Location: timelogmodel.LocationOffice},
+	}
+
+	if err := sheet.Calculate(publicHolidays, timelogs); err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if len(sheet.Days) != 31 {
+		t.Fatalf("expected 31 days but got %d", len(sheet.Days))
+	}
+
+	day := sheet.Days[3]
+	if day.Start == nil || !day.Start.Equal(start) {
+		t.Errorf("expected start %q but got %v", start, day.Start)
+	}
+
+	if expected := start.Add(11 * time.Hour); day.Stop == nil || !day.Stop.Equal(expected) {
+		t.Errorf("expected stop %q but got %v", expected, day.Stop)
+	}
+
+	if expected := (reportmodel.WorkedTime{Gross: 10, Break: 1, Net: 9}); day.WorkedTime != expected {
+		t.Errorf("expected worked time %v but got %v", expected, day.WorkedTime)
+	}
+
+	if expected := []string{timelogmodel.ReasonWork}; !reflect.DeepEqual(expected, day.Reasons) {
+		t.Errorf("expected reasons %v but got %v", expected, day.Reasons)
+	}
+
+	if expected := []string{timelogmodel.LocationHome, timelogmodel.LocationOffice}; !reflect.DeepEqual(expected, day.Locations) {
+		t.Errorf("expected locations %v but got %v", expected, day.Locations)
+	}
+
+	if day := sheet.Days[5]; day.Start != nil || len(day.Reasons) > 0 {
+		t.Errorf("expected day without stop time to be empty but got %v", day)
+	}
+
+	if warnings := sheet.Warnings["2024-03-06"]; len(warnings) != 1 {
+		t.Errorf("expected one warning for day without stop time but got %v", warnings)
+	}
+
+	if day := sheet.Days[28]; day.PublicHoliday != "Good Friday" || day.Weekend {
+		t.Errorf("expected public holiday on a workday but got %q, weekend %t", day.PublicHoliday, day.Weekend)
+	}
+
+	if day := sheet.Days[30]; !day.Weekend || day.PublicHoliday != "" {
+		t.Errorf("expected weekend without public holiday but got %q, weekend %t", day.PublicHoliday, day.Weekend)
+	}
+
+	// the vacation day is only counted per reason but not as worked time
+	if expected := (reportmodel.WorkedTime{Gross: 10, Break: 1, Net: 9}); sheet.WorkedTime != expected {
+		t.Errorf("expected worked time %v but got %v", expected, sheet.WorkedTime)
+	}
+
+	expectedDays := map[string]int{timelogmodel.ReasonWork: 1, timelogmodel.ReasonVacation: 1}
+	if !reflect.DeepEqual(expectedDays, sheet.DaysPerReason) {
+		t.Errorf("expected days per reason %v but got %v", expectedDays, sheet.DaysPerReason)
+	}
+}
+
+func TestNewYearTimesheet(t *testing.T) {
+	t.Parallel()
+
+	sheet := reportmodel.NewYearTimesheet(2024)
+	if err := sheet.Calculate(nil, nil); err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if sheet.Month != 0 {
+		t.Errorf("expected no month but got %d", sheet.Month)
+	}
+
+	if len(sheet.Days) != 366 {
+		t.Errorf("expected 366 days but got %d", len(sheet.Days))
+	}
+}
@@ -0,0 +1,123 @@
+package reportmodel
+
+import (
+	"sort"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/vacation/vacationmodel"
+)
+
+// Vacation represents the vacation days of a year compared to the entitlement. Used days are in the past, planned days
+// in the future. Expiring are the carried days which are not taken until the expiry date, once this date has passed
+// they are no longer part of the remaining days.
+type Vacation struct {
+	Year             int        `json:"Year"`
+	Entitlement      float64    `json:"Entitlement"`
+	CarryOver        float64    `json:"CarryOver"`
+	CarryOverExpires *time.Time `json:"CarryOverExpires,omitempty"`
+	CarryOverExpired bool       `json:"CarryOverExpired"`
+	Used             float64    `json:"Used"`
+	Planned          float64    `json:"Planned"`
+	Remaining        float64    `json:"Remaining"`
+	Expiring         float64    `json:"Expiring"`
+	Days             []string   `json:"Days"`
+}
+
+// NewVacation returns you a Vacation struct initialized by a given year.
+func NewVacation(year int) *Vacation {
+	return &Vacation{
+		Year: year,
+		Days: make([]string, 0),
+	}
+}
+
+// Calculate fills all values based on the entitlement, which can be nil if there is none. Vacation days are taken from
+// timelogs with reason vacation, a timelog can span several days. Weekends and public holidays are not counted, on
+// half-day public holidays only half a day is taken. Days after today are planned.
+func (v *Vacation) Calculate(
+	entitlement *vacationmodel.Entitlement,
+	publicHolidays publicholidaymodel.PublicHolidays,
+	timelogs timelogmodel.Timelogs,
+	today time.Time,
+) error {
+	if entitlement != nil {
+		v.Entitlement = entitlement.Days
+		v.CarryOver = entitlement.CarryOver
+		v.CarryOverExpires = entitlement.CarryOverExpires
+	}
+
+	holidays := daysOff(publicHolidays)
+	days := make(map[string]float64) // key = day, value = vacation taken on this day
+
+	for _, timelog := range timelogs {
+		if timelog.Reason != timelogmodel.ReasonVacation {
+			continue
+		}
+
+		for _, day := range spannedDays(timelog) {
+			keyDay := day.Format(time.DateOnly)
+			if day.Year() != v.Year || !workday(day) || holidays[keyDay] >= 1 {
+				continue
+			}
+
+			days[keyDay] = 1 - holidays[keyDay]
+		}
+	}
+
+	todayDate := date(today)
+
+	var takenUntilExpiry float64
+
+	for keyDay, taken := range days {
+		v.Days = append(v.Days, keyDay)
+		day, _ := time.Parse(time.DateOnly, keyDay)
+
+		if day.After(todayDate) {
+			v.Planned += taken
+		} else {
+			v.Used += taken
+		}
+
+		if v.CarryOverExpires != nil && !day.After(date(*v.CarryOverExpires)) {
+			takenUntilExpiry += taken
+		}
+	}
+
+	sort.Strings(v.Days)
+
+	if v.CarryOverExpires != nil && v.CarryOver > takenUntilExpiry {
+		v.Expiring = v.CarryOver - takenUntilExpiry
+		v.CarryOverExpired = date(*v.CarryOverExpires).Before(todayDate)
+	}
+
+	v.Remaining = v.Entitlement + v.CarryOver - v.Used - v.Planned
+	if v.CarryOverExpired {
+		v.Remaining -= v.Expiring
+	}
+
+	return nil
+}
+
+// spannedDays returns the dates from start to stop of the timelog. A stop at midnight doesn't include the next day.
+func spannedDays(timelog *timelogmodel.Timelog) []time.Time {
+	first := date(timelog.Start)
+	last := first
+
+	if timelog.Stop != nil && timelog.Stop.After(timelog.Start) {
+		last = date(timelog.Stop.Add(-time.Nanosecond))
+	}
+
+	var days []time.Time
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+
+	return days
+}
+
+// date returns the date of the given time at midnight in UTC.
+func date(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
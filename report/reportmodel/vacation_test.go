@@ -0,0 +1,165 @@
+package reportmodel_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/vacation/vacationmodel"
+)
+
+func TestVacation_Calculate(t *testing.T) {
+	t.Parallel()
+
+	vacation := func(startMonth, startDay, stopMonth, stopDay int) *timelogmodel.Timelog {
+		stop := time.Date(2024, time.Month(stopMonth), stopDay, 0, 0, 0, 0, time.UTC)
+
+		return &timelogmodel.Timelog{
+			Start:    time.Date(2024, time.Month(startMonth), startDay, 0, 0, 0, 0, time.UTC),
+			Stop:     &stop,
+			Reason:   timelogmodel.ReasonVacation,
+			Location: timelogmodel.LocationHome,
+		}
+	}
+
+	workStop := time.Date(2024, 3, 4, 17, 0, 0, 0, time.UTC)
+	timelogs := timelogmodel.Timelogs{
+		// Friday to Tuesday including a weekend and Easter Monday
+		vacation(3, 29, 4, 3),
+		// single day
+		vacation(2, 1, 2, 2),
+		// future
+		vacation(8, 5, 8, 10),
+		{
+			Start:    time.Date(2024, 3, 4, 8, 0, 0, 0, time.UTC),
+			Stop:     &workStop,
+			Reason:   timelogmodel.ReasonWork,
+			Location: timelogmodel.LocationHome,
+		},
+	}
+
+	publicHolidays := publicholidaymodel.PublicHolidays{
+		{Day: time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC)},
+		{Day: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	expires := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	entitlement := &vacationmodel.Entitlement{Year: 2024, Days: 30, CarryOver: 5, CarryOverExpires: &expires}
+
+	testCases := []struct {
+		name        string
+		entitlement *vacationmodel.Entitlement
+		today       time.Time
+		expected    reportmodel.Vacation
+	}{
+		{
+			name:  "without entitlement",
+			today: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			expected: reportmodel.Vacation{
+				Year: 2024, Used: 2, Planned: 5, Remaining: -7,
+			},
+		},
+		{
+			name:        "carry-over not expired yet",
+			entitlement: entitlement,
+			today:       time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			expected: reportmodel.Vacation{
+				Year: 2024, Entitlement: 30, CarryOver: 5, CarryOverExpires: &expires,
+				Used: 1, Planned: 6, Remaining: 28, Expiring: 4,
+			},
+		},
+		{
+			name:        "carry-over expired",
+			entitlement: entitlement,
+			today:       time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			expected: reportmodel.Vacation{
+				Year: 2024, Entitlement: 30, CarryOver: 5, CarryOverExpires: &expires, CarryOverExpired: true,
+				Used: 2, Planned: 5, Remaining: 24, Expiring: 4,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := reportmodel.NewVacation(2024)
+			if err := actual.Calculate(testCase.entitlement, publicHolidays, timelogs, testCase.today); err != nil {
+				t.Fatalf("Calculate error: %s", err)
+			}
+
+			expectedDays := "2024-02-01,2024-04-02,2024-08-05,2024-08-06,2024-08-07,2024-08-08,2024-08-09"
+			if strings.Join(actual.Days, ",") != expectedDays {
+				t.Errorf("Days expected %s, got %s", expectedDays, strings.Join(actual.Days, ","))
+			}
+
+			expected := testCase.expected
+			expected.Days = actual.Days
+
+			if !reflect.DeepEqual(*actual, expected) {
+				t.Errorf("expected %+v, got %+v", expected, *actual)
+			}
+		})
+	}
+}
+
+func TestVacation_CalculateHalfDayPublicHoliday(t *testing.T) {
+	t.Parallel()
+
+	stop := time.Date(2024, 12, 28, 0, 0, 0, 0, time.UTC)
+	timelogs := timelogmodel.Timelogs{
+		{
+			Start:    time.Date(2024, 12, 23, 0, 0, 0, 0, time.UTC),
+			Stop:     &stop,
+			Reason:   timelogmodel.ReasonVacation,
+			Location: timelogmodel.LocationHome,
+		},
+	}
+
+	publicHolidays := publicholidaymodel.PublicHolidays{
+		{Day: time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC), HalfDay: true},
+		{Day: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{Day: time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC)},
+	}
+
+	vacation := reportmodel.NewVacation(2024)
+	if err := vacation.Calculate(nil, publicHolidays, timelogs, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("Calculate error: %s", err)
+	}
+
+	if vacation.Used != 2.5 {
+		t.Errorf("Used expected 2.5, got %v", vacation.Used)
+	}
+}
+
+func TestVacation_CalculateAcrossYears(t *testing.T) {
+	t.Parallel()
+
+	stop := time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)
+	timelogs := timelogmodel.Timelogs{
+		{
+			Start:    time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC),
+			Stop:     &stop,
+			Reason:   timelogmodel.ReasonVacation,
+			Location: timelogmodel.LocationHome,
+		},
+	}
+
+	publicHolidays := publicholidaymodel.PublicHolidays{{Day: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}}
+	today := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	for year, expected := range map[int]string{2024: "2024-12-30,2024-12-31", 2025: "2025-01-02,2025-01-03"} {
+		vacation := reportmodel.NewVacation(year)
+		if err := vacation.Calculate(nil, publicHolidays, timelogs, today); err != nil {
+			t.Fatalf("Calculate error: %s", err)
+		}
+
+		if strings.Join(vacation.Days, ",") != expected {
+			t.Errorf("Days of %d expected %s, got %s", year, expected, strings.Join(vacation.Days, ","))
+		}
+	}
+}
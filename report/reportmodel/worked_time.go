@@ -0,0 +1,110 @@
+package reportmodel
+
+import (
+	"math"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+// WorkedTime represents the time spent in hours. Gross is the time from start to stop including breaks, Break the
+// time spent for breaks and Net the time actually worked.
+type WorkedTime struct {
+	Gross float64 `json:"Gross"`
+	Break float64 `json:"Break"`
+	Net   float64 `json:"Net"`
+}
+
+// workedTime sums up durations before they are converted to hours.
+type workedTime struct {
+	gross time.Duration
+	brk   time.Duration
+}
+
+func (w *workedTime) toModel() WorkedTime {
+	return WorkedTime{
+		Gross: hours(w.gross),
+		Break: hours(w.brk),
+		Net:   hours(w.gross - w.brk),
+	}
+}
+
+func hours(d time.Duration) float64 {
+	return math.Round(d.Hours()*100) / 100 // nolint: gomnd
+}
+
+// calculateWorkedTime fills the worked time in total, per reason and per location. Only work counts into the total
+// and the locations, the time of other reasons like sick leave is only contained per reason.
+func (r *Report) calculateWorkedTime(timelogs timelogmodel.Timelogs) {
+	total := &workedTime{}
+	perReason := make(map[string]*workedTime)
+	perLocation := make(map[string]*workedTime)
+
+	eachWorkedTime(timelogs, func(accounted *timelogmodel.Timelog, gross, brk time.Duration) {
+		if _, ok := perReason[accounted.Reason]; !ok {
+			perReason[accounted.Reason] = &workedTime{}
+		}
+
+		perReason[accounted.Reason].gross += gross
+		perReason[accounted.Reason].brk += brk
+
+		if !isWork(accounted) {
+			return
+		}
+
+		if _, ok := perLocation[accounted.Location]; !ok {
+			perLocation[accounted.Location] = &workedTime{}
+		}
+
+		for _, v := range []*workedTime{total, perLocation[accounted.Location]} {
+			v.gross += gross
+			v.brk += brk
+		}
+	})
+
+	r.WorkedTime = total.toModel()
+
+	for reason, v := range perReason {
+		r.WorkedTimePerReason[reason] = v.toModel()
+	}
+
+	for location, v := range perLocation {
+		r.WorkedTimePerLocation[location] = v.toModel()
+	}
+}
+
+// isWork returns true if the time of the timelog counts as worked time.
+func isWork(timelog *timelogmodel.Timelog) bool {
+	return timelog.Reason == timelogmodel.ReasonWork
+}
+
+// eachWorkedTime passes the gross and break time of every timelog to the callback together with the timelog it is
+// accounted to. A break contained by another timelog is accounted to this timelog, otherwise it adds to the gross time
+// itself and is accounted to its own. Timelogs without stop time are ignored.
+func eachWorkedTime(timelogs timelogmodel.Timelogs, callback func(accounted *timelogmodel.Timelog, gross, brk time.Duration)) {
+	for _, timelog := range timelogs {
+		if timelog.Stop == nil || timelog.Stop.IsZero() {
+			continue
+		}
+
+		if timelog.Reason != timelogmodel.ReasonBreak {
+			callback(timelog, timelog.Duration(), 0)
+
+			continue
+		}
+
+		container := timelog
+		gross := timelog.Duration()
+
+		for _, other := range timelogs {
+			if other.Reason != timelogmodel.ReasonBreak && other.Stop != nil && other.Contains(timelog) {
+				container = other
+				gross = 0
+
+				break
+			}
+		}
+
+		callback(container, gross, timelog.Duration())
+	}
+}
@@ -0,0 +1,173 @@
+package reportpdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Size of an A4 page in points (1/72 inch).
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Font defines one of the standard fonts available in every PDF viewer.
+type Font int
+
+const (
+	// FontRegular defines the font Helvetica.
+	FontRegular Font = iota
+
+	// FontBold defines the font Helvetica-Bold.
+	FontBold
+)
+
+var baseFonts = map[Font]string{ // nolint: gochecknoglobals
+	FontRegular: "Helvetica",
+	FontBold:    "Helvetica-Bold",
+}
+
+// Document represents a PDF document with A4 pages. Coordinates are given in points from the top left corner of the
+// page. It only supports the standard fonts, so no fonts need to be embedded.
+type Document struct {
+	pages []*bytes.Buffer
+}
+
+// NewDocument returns a new Document with an empty first page.
+func NewDocument() *Document {
+	d := &Document{}
+	d.AddPage()
+
+	return d
+}
+
+// AddPage starts a new page. All following drawings are placed on it.
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+// Text draws the text with its baseline starting at the given position.
+func (d *Document) Text(x, y float64, font Font, size float64, text string) {
+	fmt.Fprintf(
+		d.current(),
+		"BT /F%d %s Tf %s %s Td (%s) Tj ET\n",
+		font+1, number(size), number(x), number(PageHeight-y), escape(encode(text)),
+	)
+}
+
+// TextRight draws the text with its baseline ending at the given position.
+func (d *Document) TextRight(x, y float64, font Font, size float64, text string) {
+	d.Text(x-TextWidth(text, size), y, font, size, text)
+}
+
+// Line draws a black line from the first to the second position.
+func (d *Document) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(
+		d.current(),
+		"%s w %s %s m %s %s l S\n",
+		number(width), number(x1), number(PageHeight-y1), number(x2), number(PageHeight-y2),
+	)
+}
+
+// FillRect draws a filled rectangle with the top left corner at the given position. Gray is a value between 0 (black)
+// and 1 (white).
+func (d *Document) FillRect(x, y, width, height, gray float64) {
+	fmt.Fprintf(
+		d.current(),
+		"q %s g %s %s %s %s re f Q\n",
+		number(gray), number(x), number(PageHeight-y-height), number(width), number(height),
+	)
+}
+
+// WriteTo writes the complete document to the writer.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	buf := &bytes.Buffer{}
+	offsets := make([]int, 0)
+
+	object := func(content string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(buf, "%d 0 obj\n%s\nendobj\n", len(offsets), content)
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// objects 1 and 2 are catalog and page tree, followed by the fonts, followed by page and content of each page
+	firstPage := 3 + len(baseFonts)
+	kids := make([]string, len(d.pages))
+
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+
+	fonts := make([]string, len(baseFonts))
+
+	for font := FontRegular; int(font) < len(baseFonts); font++ {
+		fonts[font] = fmt.Sprintf("/F%d %d 0 R", font+1, len(offsets)+1)
+		object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", baseFonts[font]))
+	}
+
+	for i, page := range d.pages {
+		object(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << %s >> >> /Contents %d 0 R >>",
+			number(PageWidth), number(PageHeight), strings.Join(fonts, " "), firstPage+2*i+1,
+		))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+
+	xref := buf.Len()
+
+	fmt.Fprintf(buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+
+	for _, offset := range offsets {
+		fmt.Fprintf(buf, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.WriteTo(w) // nolint: wrapcheck
+}
+
+func (d *Document) current() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+// number formats the value with at most two decimals.
+func number(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64) // nolint: gomnd
+}
+
+// escape masks the characters having a special meaning in PDF strings.
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`).Replace(s)
+}
+
+// winAnsi contains the characters of the WinAnsiEncoding which differ from their unicode code point.
+var winAnsi = map[rune]byte{ // nolint: gochecknoglobals
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96,
+	'—': 0x97,
+}
+
+// encode converts the text to WinAnsiEncoding. Characters not contained are replaced by a question mark.
+func encode(text string) string {
+	b := make([]byte, 0, len(text))
+
+	for _, r := range text {
+		switch {
+		case r >= ' ' && r <= '~', r >= 0xA0 && r <= 0xFF:
+			b = append(b, byte(r))
+		case winAnsi[r] != 0:
+			b = append(b, winAnsi[r])
+		default:
+			b = append(b, '?')
+		}
+	}
+
+	return string(b)
+}
@@ -0,0 +1,89 @@
+package reportpdf_test
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/rebel-l/ttrack_api/report/reportpdf"
+)
+
+func TestDocument_WriteTo(t *testing.T) {
+	t.Parallel()
+
+	doc := reportpdf.NewDocument()
+	doc.Text(40, 50, reportpdf.FontBold, 16, "Überstunden (März) \\ 5 €")
+	doc.AddPage()
+	doc.Line(40, 60, 200, 60, 0.5)
+	doc.FillRect(40, 70, 100, 15, 0.9)
+
+	buf := &bytes.Buffer{}
+	if _, err := doc.WriteTo(buf); err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	assertPDF(t, buf.Bytes(), 2)
+
+	for _, expected := range []string{
+		"(\xdcberstunden \\(M\xe4rz\\) \\\\ 5 \x80) Tj",
+		"0.5 w 40 781.89 m 200 781.89 l S",
+		"q 0.9 g 40 756.89 100 15 re f Q",
+	} {
+		if !bytes.Contains(buf.Bytes(), []byte(expected)) {
+			t.Errorf("expected document to contain %q", expected)
+		}
+	}
+}
+
+func TestTextWidth(t *testing.T) {
+	t.Parallel()
+
+	if got := reportpdf.TextWidth("10.00", 10); got != 25.02 {
+		t.Errorf("expected width 25.02 but got %v", got)
+	}
+
+	if got := reportpdf.Fit("Public holiday", 30, 10); got != "Public" {
+		t.Errorf("expected text to be shortened to 'Public' but got %q", got)
+	}
+}
+
+// assertPDF checks the structure of the document: header, cross-reference table pointing to the objects and trailer.
+func assertPDF(t *testing.T, pdf []byte, pages int) {
+	t.Helper()
+
+	if !bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")) {
+		t.Errorf("expected PDF header")
+	}
+
+	if !bytes.HasSuffix(pdf, []byte("%%EOF\n")) {
+		t.Errorf("expected end of file marker")
+	}
+
+	if expected := fmt.Sprintf("/Count %d", pages); !bytes.Contains(pdf, []byte(expected)) {
+		t.Errorf("expected document to contain %q", expected)
+	}
+
+	match := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(pdf)
+	if match == nil {
+		t.Fatalf("expected startxref")
+	}
+
+	xref, _ := strconv.Atoi(string(match[1]))
+	if !bytes.HasPrefix(pdf[xref:], []byte("xref\n")) {
+		t.Fatalf("expected cross-reference table at offset %d", xref)
+	}
+
+	offsets := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(pdf[xref:], -1)
+	if len(offsets) == 0 {
+		t.Fatalf("expected objects in cross-reference table")
+	}
+
+	for i, offset := range offsets {
+		pos, _ := strconv.Atoi(string(offset[1]))
+		if expected := fmt.Sprintf("%d 0 obj\n", i+1); !bytes.HasPrefix(pdf[pos:], []byte(expected)) {
+			t.Errorf("expected object %d at offset %d", i+1, pos)
+		}
+	}
+}
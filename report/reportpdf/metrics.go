@@ -0,0 +1,46 @@
+package reportpdf
+
+const (
+	// widthUnits defines the units per font size the widths are given in.
+	widthUnits = 1000
+
+	// defaultWidth is used for all characters without a width in the table below.
+	defaultWidth = 556
+)
+
+// helveticaWidths contains the widths of the printable ASCII characters of Helvetica starting at the space character.
+var helveticaWidths = [...]int{ // nolint: gochecknoglobals
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // space - /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556, // 0 - ?
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778, // @ - O
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556, // P - _
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556, // ` - o
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584, // p - ~
+}
+
+// TextWidth returns the width of the text in points for the given font size. The widths of Helvetica are used for all
+// fonts, so for bold text it is an approximation.
+func TextWidth(text string, size float64) float64 {
+	width := 0
+
+	for _, r := range text {
+		if r >= ' ' && int(r-' ') < len(helveticaWidths) {
+			width += helveticaWidths[r-' ']
+		} else {
+			width += defaultWidth
+		}
+	}
+
+	return float64(width) * size / widthUnits
+}
+
+// Fit shortens the text so it doesn't exceed the given width.
+func Fit(text string, width, size float64) string {
+	runes := []rune(text)
+
+	for len(runes) > 0 && TextWidth(string(runes), size) > width {
+		runes = runes[:len(runes)-1]
+	}
+
+	return string(runes)
+}
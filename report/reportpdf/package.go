@@ -0,0 +1,2 @@
+// Package reportpdf provides a minimal PDF writer and renders reports of timelogs as PDF documents.
+package reportpdf
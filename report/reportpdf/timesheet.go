@@ -0,0 +1,198 @@
+package reportpdf
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+)
+
+const (
+	margin        = 40
+	fontSize      = 9
+	titleSize     = 16
+	rowHeight     = 15
+	shade         = 0.9
+	lineWidth     = 0.5
+	signatureSize = 200
+	signatureGap  = 60
+
+	// footerHeight is the space needed below the table for totals and signatures.
+	footerHeight = 130
+)
+
+type column struct {
+	title string
+	x     float64
+	width float64
+	right bool
+}
+
+// columns of the timesheet table, right aligned columns have their x at the right border.
+var columns = []column{ // nolint: gochecknoglobals
+	{title: "Date", x: margin, width: 60},
+	{title: "Day", x: 102, width: 26},
+	{title: "Start", x: 130, width: 36},
+	{title: "Stop", x: 168, width: 36},
+	{title: "Break", x: 240, width: 34, right: true},
+	{title: "Net", x: 285, width: 40, right: true},
+	{title: "Reason", x: 298, width: 92},
+	{title: "Location", x: 392, width: 64},
+	{title: "Public holiday", x: 458, width: PageWidth - margin - 458},
+}
+
+// WriteTimesheet renders the timesheet as PDF document to the writer. Weekends and public holidays are shaded, the
+// totals of the month and lines to sign for employee and employer follow the table.
+func WriteTimesheet(w io.Writer, sheet *reportmodel.Timesheet) error {
+	doc := NewDocument()
+	y := header(doc, sheet)
+
+	for _, day := range sheet.Days {
+		if y+rowHeight > PageHeight-margin {
+			doc.AddPage()
+			y = tableHeader(doc, margin)
+		}
+
+		if day.Weekend || day.PublicHoliday != "" {
+			doc.FillRect(margin, y-rowHeight+4, PageWidth-2*margin, rowHeight, shade)
+		}
+
+		row(doc, y, timesheetRow(day))
+		y += rowHeight
+	}
+
+	if y+footerHeight > PageHeight-margin {
+		doc.AddPage()
+		y = margin
+	}
+
+	y = totals(doc, y, sheet)
+	signatures(doc, y+signatureGap)
+
+	if _, err := doc.WriteTo(w); err != nil {
+		return fmt.Errorf("failed to write timesheet: %w", err)
+	}
+
+	return nil
+}
+
+func header(doc *Document, sheet *reportmodel.Timesheet) float64 {
+	y := float64(margin + titleSize)
+	doc.Text(margin, y, FontBold, titleSize, fmt.Sprintf("Timesheet %s %d", time.Month(sheet.Month), sheet.Year))
+
+	y += 2 * fontSize
+	doc.Text(margin, y, FontRegular, fontSize, fmt.Sprintf(
+		"Period: %s - %s", sheet.FirstDay.Format(time.DateOnly), sheet.LastDay.Format(time.DateOnly),
+	))
+
+	if sheet.Calendar != "" {
+		y += rowHeight
+		doc.Text(margin, y, FontRegular, fontSize, "Public holidays: "+sheet.Calendar)
+	}
+
+	return tableHeader(doc, y+2*rowHeight)
+}
+
+// tableHeader draws the column titles at the given position and returns the position of the first row.
+func tableHeader(doc *Document, y float64) float64 {
+	titles := make([]string, len(columns))
+	for i, c := range columns {
+		titles[i] = c.title
+	}
+
+	row(doc, y, titles)
+	doc.Line(margin, y+4, PageWidth-margin, y+4, lineWidth)
+
+	return y + rowHeight
+}
+
+func row(doc *Document, y float64, values []string) {
+	for i, c := range columns {
+		value := Fit(values[i], c.width, fontSize)
+
+		if c.right {
+			doc.TextRight(c.x, y, FontRegular, fontSize, value)
+		} else {
+			doc.Text(c.x, y, FontRegular, fontSize, value)
+		}
+	}
+}
+
+func timesheetRow(day *reportmodel.TimesheetDay) []string {
+	values := []string{
+		day.Day.Format(time.DateOnly),
+		day.Day.Format("Mon"),
+		"", "", "", "",
+		strings.Join(day.Reasons, ", "),
+		strings.Join(day.Locations, ", "),
+		day.PublicHoliday,
+	}
+
+	if day.Start != nil && day.Stop != nil {
+		values[2] = day.Start.Format("15:04")
+		values[3] = day.Stop.Format("15:04")
+		values[4] = fmt.Sprintf("%.2f", day.WorkedTime.Break)
+		values[5] = fmt.Sprintf("%.2f", day.WorkedTime.Net)
+	}
+
+	if day.HalfDay {
+		values[8] += " (half day)"
+	}
+
+	return values
+}
+
+func totals(doc *Document, y float64, sheet *reportmodel.Timesheet) float64 {
+	doc.Line(margin, y-rowHeight+4, PageWidth-margin, y-rowHeight+4, lineWidth)
+
+	doc.Text(margin, y, FontBold, fontSize, "Total")
+	doc.TextRight(columns[4].x, y, FontBold, fontSize, fmt.Sprintf("%.2f", sheet.WorkedTime.Break))
+	doc.TextRight(columns[5].x, y, FontBold, fontSize, fmt.Sprintf("%.2f", sheet.WorkedTime.Net))
+
+	y += rowHeight
+	doc.Text(margin, y, FontRegular, fontSize, fmt.Sprintf(
+		"Gross hours: %.2f, breaks: %.2f, net hours: %.2f",
+		sheet.WorkedTime.Gross, sheet.WorkedTime.Break, sheet.WorkedTime.Net,
+	))
+
+	days := make([]string, 0)
+
+	for _, reason := range reasons(sheet) {
+		days = append(days, fmt.Sprintf("%s: %d", reason, sheet.DaysPerReason[reason]))
+	}
+
+	if len(days) > 0 {
+		y += rowHeight
+		doc.Text(margin, y, FontRegular, fontSize, "Days per reason: "+strings.Join(days, ", "))
+	}
+
+	return y
+}
+
+func signatures(doc *Document, y float64) {
+	for i, caption := range []string{"Date, signature employee", "Date, signature employer"} {
+		x := margin + float64(i)*(PageWidth-2*margin-signatureSize)
+		doc.Line(x, y, x+signatureSize, y, lineWidth)
+		doc.Text(x, y+rowHeight, FontRegular, fontSize, caption)
+	}
+}
+
+// reasons returns the reasons of the timesheet in the order of the days they appear first.
+func reasons(sheet *reportmodel.Timesheet) []string {
+	list := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, day := range sheet.Days {
+		for _, reason := range day.Reasons {
+			if !seen[reason] {
+				seen[reason] = true
+
+				list = append(list, reason)
+			}
+		}
+	}
+
+	return list
+}
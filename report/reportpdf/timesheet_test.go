@@ -0,0 +1,55 @@
+package reportpdf_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/publicholiday/publicholidaymodel"
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/report/reportpdf"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+func TestWriteTimesheet(t *testing.T) {
+	t.Parallel()
+
+	sheet, err := reportmodel.NewTimesheet(2024, time.May)
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	start := time.Date(2024, 5, 2, 8, 30, 0, 0, time.UTC)
+	stop := start.Add(8 * time.Hour)
+
+	sheet.Calendar = "Berlin"
+	if err := sheet.Calculate(
+		publicholidaymodel.PublicHolidays{{Day: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Name: "Tag der Arbeit"}},
+		timelogmodel.Timelogs{{Start: start, Stop: &stop, Reason: timelogmodel.ReasonWork, Location: timelogmodel.LocationHome}},
+	); err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := reportpdf.WriteTimesheet(buf, sheet); err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	assertPDF(t, buf.Bytes(), 1)
+
+	for _, expected := range []string{
+		"(Timesheet May 2024) Tj",
+		"(Public holidays: Berlin) Tj",
+		"(Tag der Arbeit) Tj",
+		"(2024-05-02) Tj",
+		"(08:30) Tj",
+		"(16:30) Tj",
+		"(8.00) Tj",
+		"(Days per reason: work: 1) Tj",
+		"(Date, signature employer) Tj",
+	} {
+		if !bytes.Contains(buf.Bytes(), []byte(expected)) {
+			t.Errorf("expected timesheet to contain %q", expected)
+		}
+	}
+}
@@ -0,0 +1,2 @@
+// Package reportxlsx provides a minimal spreadsheet writer and renders reports of timelogs as XLSX workbooks.
+package reportxlsx
@@ -0,0 +1,183 @@
+package reportxlsx
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"golang.org/x/exp/maps"
+)
+
+// WriteReport writes the report as XLSX workbook to the writer. It contains a sheet with the summary of the report, a
+// sheet with the details per day of the timesheet and a sheet with the raw timelogs. Days having warnings in the
+// report are highlighted on every sheet.
+func WriteReport(
+	w io.Writer,
+	report *reportmodel.Report,
+	timesheet *reportmodel.Timesheet,
+	timelogs timelogmodel.Timelogs,
+) error {
+	workbook := NewWorkbook()
+
+	summary(workbook.AddSheet("Summary"), report)
+	days(workbook.AddSheet("Days"), timesheet, report.Warnings)
+	rawTimelogs(workbook.AddSheet("Timelogs"), timelogs, report.Warnings)
+
+	return workbook.Write(w)
+}
+
+func summary(sheet *Sheet, report *reportmodel.Report) {
+	sheet.AddRow(StyleHeader, "Report", report.Year)
+	sheet.AddRow(StyleDefault, "Calendar", report.Calendar)
+	sheet.AddRow(StyleDefault, "First day", report.FirstDay.Format(time.DateOnly))
+	sheet.AddRow(StyleDefault, "Last day", report.LastDay.Format(time.DateOnly))
+	sheet.AddRow(StyleDefault, "Days", report.Days)
+	sheet.AddRow(StyleDefault, "Work days", report.WorkDays)
+	sheet.AddRow(StyleDefault, "Days on weekend", report.DaysOnWeekend)
+	sheet.AddRow(StyleDefault, "Public holidays", report.PublicHolidays)
+	sheet.AddRow(StyleDefault, "Public holidays on workdays", report.PublicHolidaysOnWorkdays)
+
+	sheet.AddRow(StyleDefault)
+	sheet.AddRow(StyleHeader, "Worked time", "Gross", "Break", "Net")
+	workedTime(sheet, "Total", report.WorkedTime)
+
+	for _, reason := range sortedKeys(report.WorkedTimePerReason) {
+		workedTime(sheet, "Reason: "+reason, report.WorkedTimePerReason[reason])
+	}
+
+	for _, location := range sortedKeys(report.WorkedTimePerLocation) {
+		workedTime(sheet, "Location: "+location, report.WorkedTimePerLocation[location])
+	}
+
+	sheet.AddRow(StyleDefault)
+	sheet.AddRow(StyleHeader, "Work days per", "Name", "Days")
+
+	for _, v := range []struct {
+		title string
+		days  map[string]uint32
+	}{
+		{title: "Reason", days: report.WorkDaysPerReason},
+		{title: "Location", days: report.WorkDaysPerLocation},
+		{title: "Project", days: report.WorkDaysPerProject},
+		{title: "Tag", days: report.WorkDaysPerTag},
+	} {
+		for _, name := range sortedKeys(v.days) {
+			sheet.AddRow(StyleDefault, v.title, name, v.days[name])
+		}
+	}
+
+	if len(report.Months) > 0 {
+		sheet.AddRow(StyleDefault)
+		sheet.AddRow(StyleHeader, "Month", "Work days", "Gross", "Break", "Net")
+
+		for _, month := range report.Months {
+			sheet.AddRow(
+				StyleDefault,
+				time.Month(month.Month).String(),
+				month.WorkDays,
+				month.WorkedTime.Gross,
+				month.WorkedTime.Break,
+				month.WorkedTime.Net,
+			)
+		}
+	}
+
+	if len(report.Warnings) > 0 {
+		sheet.AddRow(StyleDefault)
+		sheet.AddRow(StyleHeader, "Warnings", "Message")
+
+		for _, day := range sortedKeys(report.Warnings) {
+			for _, message := range report.Warnings[day] {
+				sheet.AddRow(StyleHighlight, day, message)
+			}
+		}
+	}
+}
+
+func workedTime(sheet *Sheet, title string, w reportmodel.WorkedTime) {
+	sheet.AddRow(StyleDefault, title, w.Gross, w.Break, w.Net)
+}
+
+func days(sheet *Sheet, timesheet *reportmodel.Timesheet, warnings map[string][]string) {
+	sheet.AddRow(
+		StyleHeader,
+		"Date", "Day", "Start", "Stop", "Gross", "Break", "Net", "Reason", "Location", "Public holiday", "Warnings",
+	)
+
+	for _, day := range timesheet.Days {
+		keyDay := day.Day.Format(time.DateOnly)
+		values := []any{
+			keyDay,
+			day.Day.Format("Mon"),
+			nil, nil, nil, nil, nil,
+			strings.Join(day.Reasons, ", "),
+			strings.Join(day.Locations, ", "),
+			day.PublicHoliday,
+			strings.Join(warnings[keyDay], ", "),
+		}
+
+		if day.Start != nil && day.Stop != nil {
+			values[2] = day.Start.Format("15:04")
+			values[3] = day.Stop.Format("15:04")
+			values[4] = day.WorkedTime.Gross
+			values[5] = day.WorkedTime.Break
+			values[6] = day.WorkedTime.Net
+		}
+
+		if day.HalfDay {
+			values[9] = day.PublicHoliday + " (half day)"
+		}
+
+		sheet.AddRow(style(warnings, keyDay), values...)
+	}
+}
+
+func rawTimelogs(sheet *Sheet, timelogs timelogmodel.Timelogs, warnings map[string][]string) {
+	sheet.AddRow(
+		StyleHeader,
+		"ID", "Start", "Stop", "Reason", "Location", "Project", "Description", "Tags", "Hours",
+	)
+
+	for _, t := range timelogs {
+		values := []any{
+			t.ID.String(),
+			t.Start.Format(time.RFC3339),
+			nil,
+			t.Reason,
+			t.Location,
+			nil,
+			t.Description,
+			strings.Join(t.Tags, timelogmodel.CSVTagSeparator),
+			nil,
+		}
+
+		if t.Stop != nil {
+			values[2] = t.Stop.Format(time.RFC3339)
+			values[8] = t.Duration().Hours()
+		}
+
+		if t.ProjectID != nil {
+			values[5] = t.ProjectID.String()
+		}
+
+		sheet.AddRow(style(warnings, t.Start.Format(time.DateOnly)), values...)
+	}
+}
+
+func style(warnings map[string][]string, keyDay string) Style {
+	if len(warnings[keyDay]) > 0 {
+		return StyleHighlight
+	}
+
+	return StyleDefault
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := maps.Keys(m)
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,65 @@
+package reportxlsx_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/report/reportmodel"
+	"github.com/rebel-l/ttrack_api/report/reportxlsx"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+func TestWriteReport(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 2, 8, 0, 0, 0, time.UTC)
+	stop := start.Add(8 * time.Hour)
+
+	timelogs := timelogmodel.Timelogs{
+		{Start: start, Stop: &stop, Reason: timelogmodel.ReasonWork, Location: timelogmodel.LocationOffice},
+		{Start: start.Add(24 * time.Hour), Reason: timelogmodel.ReasonWork, Location: timelogmodel.LocationHome},
+	}
+
+	report := reportmodel.NewReport(2024)
+	if err := report.Calculate(nil, timelogs); err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	timesheet := reportmodel.NewYearTimesheet(2024)
+	if err := timesheet.Calculate(nil, timelogs); err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := reportxlsx.WriteReport(buf, report, timesheet, timelogs); err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	files := readXLSX(t, buf.Bytes())
+
+	for name, expected := range map[string][]string{
+		"xl/workbook.xml": {`name="Summary"`, `name="Days"`, `name="Timelogs"`},
+		"xl/worksheets/sheet1.xml": {
+			`<c r="A1" s="1" t="inlineStr"><is><t xml:space="preserve">Report</t></is></c><c r="B1" s="1"><v>2024</v></c>`,
+			`s="2" t="inlineStr"><is><t xml:space="preserve">no stop time</t>`,
+		},
+		"xl/worksheets/sheet2.xml": {
+			`<row r="3"><c r="A3" s="0" t="inlineStr"><is><t xml:space="preserve">2024-01-02</t></is></c>`,
+			`<c r="E3" s="0"><v>8</v></c>`,
+			`<row r="4"><c r="A4" s="2" t="inlineStr"><is><t xml:space="preserve">2024-01-03</t></is></c>`,
+			`<row r="367">`,
+		},
+		"xl/worksheets/sheet3.xml": {
+			`<row r="2"><c r="A2" s="0"`,
+			`<row r="3"><c r="A3" s="2"`,
+		},
+	} {
+		for _, v := range expected {
+			if !strings.Contains(files[name], v) {
+				t.Errorf("expected %q to contain %q", name, v)
+			}
+		}
+	}
+}
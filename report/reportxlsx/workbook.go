@@ -0,0 +1,251 @@
+package reportxlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Style defines the formatting of a row.
+type Style int
+
+const (
+	// StyleDefault defines rows without special formatting.
+	StyleDefault Style = iota
+
+	// StyleHeader defines rows with bold text, e.g. column titles.
+	StyleHeader
+
+	// StyleHighlight defines rows with a yellow background, e.g. to show warnings.
+	StyleHighlight
+)
+
+// maxLengthSheetName is the maximum length of a sheet name accepted by spreadsheet applications.
+const maxLengthSheetName = 31
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+const contentTypes = xmlHeader +
+	`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ` +
+	`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ` +
+	`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`%s</Types>`
+
+const rootRelations = xmlHeader +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" ` +
+	`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" ` +
+	`Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+// styles contains the cell formats in the order of the Style constants.
+const styles = xmlHeader +
+	`<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font>` +
+	`<font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="3"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill>` +
+	`<fill><patternFill patternType="solid"><fgColor rgb="FFFFFF00"/><bgColor indexed="64"/></patternFill></fill>` +
+	`</fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="3"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="0" fontId="0" fillId="2" borderId="0" xfId="0" applyFill="1"/></cellXfs>` +
+	`</styleSheet>`
+
+// Workbook represents a spreadsheet document with one or more sheets.
+type Workbook struct {
+	sheets []*Sheet
+}
+
+// Sheet represents a single table of a Workbook.
+type Sheet struct {
+	name string
+	rows []row
+}
+
+type row struct {
+	style  Style
+	values []any
+}
+
+// NewWorkbook returns a new Workbook without sheets.
+func NewWorkbook() *Workbook {
+	return &Workbook{}
+}
+
+// AddSheet adds a sheet with the given name to the workbook. Names longer than 31 characters are shortened.
+func (w *Workbook) AddSheet(name string) *Sheet {
+	if runes := []rune(name); len(runes) > maxLengthSheetName {
+		name = string(runes[:maxLengthSheetName])
+	}
+
+	s := &Sheet{name: name}
+	w.sheets = append(w.sheets, s)
+
+	return s
+}
+
+// AddRow appends a row with the given values to the sheet. Numbers are stored as numbers, nil as empty cell and all
+// other values as text.
+func (s *Sheet) AddRow(style Style, values ...any) {
+	s.rows = append(s.rows, row{style: style, values: values})
+}
+
+// Write writes the workbook as XLSX file to the writer.
+func (w *Workbook) Write(writer io.Writer) error {
+	files := []struct {
+		name    string
+		content string
+	}{
+		{name: "[Content_Types].xml", content: w.contentTypes()},
+		{name: "_rels/.rels", content: rootRelations},
+		{name: "xl/workbook.xml", content: w.workbook()},
+		{name: "xl/_rels/workbook.xml.rels", content: w.relations()},
+		{name: "xl/styles.xml", content: styles},
+	}
+
+	for i, sheet := range w.sheets {
+		files = append(files, struct {
+			name    string
+			content string
+		}{name: fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), content: sheet.xml()})
+	}
+
+	archive := zip.NewWriter(writer)
+
+	for _, file := range files {
+		f, err := archive.Create(file.name)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", file.name, err)
+		}
+
+		if _, err := io.WriteString(f, file.content); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file.name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to close workbook: %w", err)
+	}
+
+	return nil
+}
+
+func (w *Workbook) contentTypes() string {
+	overrides := &strings.Builder{}
+
+	for i := range w.sheets {
+		fmt.Fprintf(
+			overrides,
+			`<Override PartName="/xl/worksheets/sheet%d.xml" `+
+				`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`,
+			i+1,
+		)
+	}
+
+	return fmt.Sprintf(contentTypes, overrides.String())
+}
+
+func (w *Workbook) workbook() string {
+	b := &strings.Builder{}
+	b.WriteString(xmlHeader)
+	b.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+
+	for i, sheet := range w.sheets {
+		fmt.Fprintf(b, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escape(sheet.name), i+1, i+1)
+	}
+
+	b.WriteString(`</sheets></workbook>`)
+
+	return b.String()
+}
+
+func (w *Workbook) relations() string {
+	b := &strings.Builder{}
+	b.WriteString(xmlHeader)
+	b.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+
+	for i := range w.sheets {
+		fmt.Fprintf(
+			b,
+			`<Relationship Id="rId%d" `+
+				`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" `+
+				`Target="worksheets/sheet%d.xml"/>`,
+			i+1, i+1,
+		)
+	}
+
+	// the styles need an id not used by the sheets
+	fmt.Fprintf(
+		b,
+		`<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" `+
+			`Target="styles.xml"/>`,
+		len(w.sheets)+1,
+	)
+
+	b.WriteString(`</Relationships>`)
+
+	return b.String()
+}
+
+func (s *Sheet) xml() string {
+	b := &strings.Builder{}
+	b.WriteString(xmlHeader)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	for i, r := range s.rows {
+		fmt.Fprintf(b, `<row r="%d">`, i+1)
+
+		for j, value := range r.values {
+			ref := Column(j) + strconv.Itoa(i+1)
+
+			switch v := value.(type) {
+			case nil:
+				fmt.Fprintf(b, `<c r="%s" s="%d"/>`, ref, r.style)
+			case int, int64, uint32, float64:
+				fmt.Fprintf(b, `<c r="%s" s="%d"><v>%v</v></c>`, ref, r.style, v)
+			default:
+				fmt.Fprintf(
+					b,
+					`<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`,
+					ref, r.style, escape(fmt.Sprint(v)),
+				)
+			}
+		}
+
+		b.WriteString(`</row>`)
+	}
+
+	b.WriteString(`</sheetData></worksheet>`)
+
+	return b.String()
+}
+
+// Column returns the name of the column with the given zero based index, e.g. A for 0 and AA for 26.
+func Column(index int) string {
+	name := ""
+
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name // nolint: gomnd
+		index = index/26 - 1                     // nolint: gomnd
+	}
+
+	return name
+}
+
+func escape(s string) string {
+	buf := &bytes.Buffer{}
+	_ = xml.EscapeText(buf, []byte(s))
+
+	return buf.String()
+}
@@ -0,0 +1,95 @@
+package reportxlsx_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/rebel-l/ttrack_api/report/reportxlsx"
+)
+
+func TestColumn(t *testing.T) {
+	t.Parallel()
+
+	for index, expected := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		if got := reportxlsx.Column(index); got != expected {
+			t.Errorf("expected column %q for index %d but got %q", expected, index, got)
+		}
+	}
+}
+
+func TestWorkbook_Write(t *testing.T) {
+	t.Parallel()
+
+	workbook := reportxlsx.NewWorkbook()
+	sheet := workbook.AddSheet("A very long name for a sheet exceeding the limit")
+	sheet.AddRow(reportxlsx.StyleHeader, "Name", "Value")
+	sheet.AddRow(reportxlsx.StyleHighlight, "Öl & <Wasser>", 1.5, nil, 3)
+
+	buf := &bytes.Buffer{}
+	if err := workbook.Write(buf); err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	files := readXLSX(t, buf.Bytes())
+
+	for _, name := range []string{
+		"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml",
+		"xl/worksheets/sheet1.xml",
+	} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("expected file %q in workbook", name)
+		}
+	}
+
+	for name, expected := range map[string]string{
+		"xl/workbook.xml":          `<sheet name="A very long name for a sheet ex" sheetId="1" r:id="rId1"/>`,
+		"xl/worksheets/sheet1.xml": `<c r="A2" s="2" t="inlineStr"><is><t xml:space="preserve">Öl &amp; &lt;Wasser&gt;</t></is></c><c r="B2" s="2"><v>1.5</v></c><c r="C2" s="2"/><c r="D2" s="2"><v>3</v></c>`,
+	} {
+		if !strings.Contains(files[name], expected) {
+			t.Errorf("expected %q to contain %q but got %q", name, expected, files[name])
+		}
+	}
+}
+
+// readXLSX returns the content of all files in the workbook and checks they contain well-formed XML.
+func readXLSX(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("expected a zip archive but got '%v'", err)
+	}
+
+	files := make(map[string]string)
+
+	for _, file := range archive.File {
+		f, err := file.Open()
+		if err != nil {
+			t.Fatalf("failed to open %q: %v", file.Name, err)
+		}
+
+		content, err := io.ReadAll(f)
+		_ = f.Close()
+
+		if err != nil {
+			t.Fatalf("failed to read %q: %v", file.Name, err)
+		}
+
+		decoder := xml.NewDecoder(bytes.NewReader(content))
+		for {
+			if _, err := decoder.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("expected well-formed XML in %q but got '%v'", file.Name, err)
+			}
+		}
+
+		files[file.Name] = string(content)
+	}
+
+	return files
+}
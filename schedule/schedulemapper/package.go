@@ -0,0 +1,2 @@
+// Package schedulemapper provides functionality to read and persist work schedules.
+package schedulemapper
@@ -0,0 +1,188 @@
+package schedulemapper
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+	"github.com/rebel-l/ttrack_api/schedule/schedulemodel"
+	"github.com/rebel-l/ttrack_api/schedule/schedulestore"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+var (
+	// ErrLoadFromDB occurs if something went wrong on loading.
+	ErrLoadFromDB = errors.New("failed to load schedule from database")
+
+	// ErrNoData occurs if given model is nil.
+	ErrNoData = errors.New("schedule is nil")
+
+	// ErrSaveToDB occurs if something went wrong on saving.
+	ErrSaveToDB = errors.New("failed to save schedule to database")
+
+	// ErrDeleteFromDB occurs if something went wrong on deleting.
+	ErrDeleteFromDB = errors.New("failed to delete schedule from database")
+
+	// ErrNotFound occurs if record doesn't exist in database.
+	ErrNotFound = errors.New("schedule was not found")
+
+	// ErrValidFromExists occurs if another schedule starts on the same day.
+	ErrValidFromExists = errors.New("there is already a schedule valid from this day")
+)
+
+// Mapper provides methods to load and persist schedule models.
+type Mapper struct {
+	db *sqlx.DB
+}
+
+// New returns a new mapper.
+func New(db *sqlx.DB) *Mapper {
+	return &Mapper{db: db}
+}
+
+// Load returns a schedule model loaded from database by ID. Schedules of other users than the one of the context are
+// not found.
+func (m *Mapper) Load(ctx context.Context, id uuid.UUID) (*schedulemodel.Schedule, error) {
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoadFromDB, err)
+	}
+
+	s := &schedulestore.Schedule{ID: id} // nolint: exhaustivestruct
+
+	if err := s.Read(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	if s.UserID != userID {
+		return nil, ErrNotFound
+	}
+
+	return StoreToModel(s), nil
+}
+
+// Save persists (create or update) the model and returns the changed data (id, createdAt or modifiedAt). The schedule
+// belongs to the user of the context, schedules of other users are not found. It fails with ErrValidFromExists if
+// another schedule of the user starts on the same day.
+func (m *Mapper) Save(ctx context.Context, model *schedulemodel.Schedule) (*schedulemodel.Schedule, error) {
+	if model == nil {
+		return nil, ErrNoData
+	}
+
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSaveToDB, err)
+	}
+
+	model.UserID = userID
+	s := modelToStore(model)
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	if !uuidutils.IsEmpty(s.ID) {
+		stored := &schedulestore.Schedule{ID: s.ID} // nolint: exhaustivestruct
+		if err := stored.Read(ctx, tx); errors.Is(err, sql.ErrNoRows) || err == nil && stored.UserID != userID {
+			return nil, fmt.Errorf("%w: %w", ErrSaveToDB, ErrNotFound)
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+		}
+	}
+
+	existing := &schedulestore.Schedules{}
+	where := "user_id = ? AND valid_from = ? AND id != ?"
+
+	if err := existing.Load(ctx, tx, where, userID, s.ValidFrom, s.ID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	if len(*existing) > 0 {
+		return nil, ErrValidFromExists
+	}
+
+	if uuidutils.IsEmpty(model.ID) {
+		if err := s.Create(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	} else {
+		if err := s.Update(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// Delete removes a model from database by ID. Schedules of other users than the one of the context are kept and not
+// found.
+func (m *Mapper) Delete(ctx context.Context, id uuid.UUID) error {
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDeleteFromDB, err)
+	}
+
+	s := &schedulestore.Schedule{ID: id, UserID: userID} // nolint: exhaustivestruct
+	if err := s.Delete(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	} else if err != nil {
+		return fmt.Errorf("%w: %v", ErrDeleteFromDB, err)
+	}
+
+	return nil
+}
+
+// StoreToModel returns a model based on the given store object. It maps all properties from store to model.
+func StoreToModel(s *schedulestore.Schedule) *schedulemodel.Schedule {
+	if s == nil {
+		return &schedulemodel.Schedule{} // nolint: exhaustivestruct
+	}
+
+	return &schedulemodel.Schedule{
+		ID:         s.ID,
+		UserID:     s.UserID,
+		ValidFrom:  s.ValidFrom,
+		Monday:     s.Monday,
+		Tuesday:    s.Tuesday,
+		Wednesday:  s.Wednesday,
+		Thursday:   s.Thursday,
+		Friday:     s.Friday,
+		Saturday:   s.Saturday,
+		Sunday:     s.Sunday,
+		CreatedAt:  s.CreatedAt,
+		ModifiedAt: s.ModifiedAt,
+	}
+}
+
+// modelToStore returns a store based on the given model object. It maps all properties from model to store. The valid
+// from date is stored in UTC, so schedules are comparable day by day.
+func modelToStore(m *schedulemodel.Schedule) *schedulestore.Schedule {
+	return &schedulestore.Schedule{
+		ID:         m.ID,
+		UserID:     m.UserID,
+		ValidFrom:  time.Date(m.ValidFrom.Year(), m.ValidFrom.Month(), m.ValidFrom.Day(), 0, 0, 0, 0, time.UTC),
+		Monday:     m.Monday,
+		Tuesday:    m.Tuesday,
+		Wednesday:  m.Wednesday,
+		Thursday:   m.Thursday,
+		Friday:     m.Friday,
+		Saturday:   m.Saturday,
+		Sunday:     m.Sunday,
+		CreatedAt:  m.CreatedAt,
+		ModifiedAt: m.ModifiedAt,
+	}
+}
@@ -0,0 +1,133 @@
+package schedulemapper_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rebel-l/go-utils/osutils"
+	"github.com/rebel-l/go-utils/testingutils"
+	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/config"
+	"github.com/rebel-l/ttrack_api/schedule/schedulemapper"
+	"github.com/rebel-l/ttrack_api/schedule/schedulemodel"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func setup(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+
+	// 0. init path
+	storagePath := filepath.Join(".", "..", "..", "storage", "test_schedule", name)
+	scriptPath := filepath.Join(".", "..", "..", "scripts", "sql", "sqlite")
+	conf := &config.Database{
+		StoragePath:       &storagePath,
+		SchemaScriptsPath: &scriptPath,
+	}
+
+	// 1. clean up
+	if osutils.FileOrPathExists(conf.GetStoragePath()) {
+		if err := os.RemoveAll(conf.GetStoragePath()); err != nil {
+			t.Fatalf("failed to cleanup test files: %v", err)
+		}
+	}
+
+	// 2. init database
+	db, err := bootstrap.Database(conf, "0.0.0", false)
+	if err != nil {
+		t.Fatalf("No error expected: %v", err)
+	}
+
+	return db
+}
+
+func TestMapper_SaveLoad(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperSaveLoad")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := schedulemapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+
+	// 2. test
+	if _, err := mapper.Save(ctx, nil); !errors.Is(err, schedulemapper.ErrNoData) {
+		t.Errorf("expected error '%v' but got '%v'", schedulemapper.ErrNoData, err)
+	}
+
+	if _, err := mapper.Load(ctx, testingutils.UUIDParse(t, "5f0d3c1e-7b1a-4c8e-9d2f-6a5b4c3d2e1f")); !errors.Is(err, schedulemapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", schedulemapper.ErrNotFound, err)
+	}
+
+	second, err := mapper.Save(ctx, &schedulemodel.Schedule{
+		ValidFrom: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+		Monday:    6,
+		Tuesday:   6,
+		Wednesday: 6,
+		Thursday:  6,
+		Friday:    6,
+	})
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	first, err := mapper.Save(ctx, &schedulemodel.Schedule{
+		ValidFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Monday:    8,
+	})
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	_, err = mapper.Save(ctx, &schedulemodel.Schedule{ValidFrom: second.ValidFrom, Monday: 4})
+	if !errors.Is(err, schedulemapper.ErrValidFromExists) {
+		t.Errorf("expected error '%v' but got '%v'", schedulemapper.ErrValidFromExists, err)
+	}
+
+	first.Friday = 7.5
+
+	if _, err := mapper.Save(ctx, first); err != nil {
+		t.Fatalf("expected no error on update but got '%v'", err)
+	}
+
+	loaded, err := mapper.Load(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if loaded.Monday != 8 || loaded.Friday != 7.5 || !loaded.ValidFrom.Equal(first.ValidFrom) {
+		t.Errorf("expected schedule '%v' but got '%v'", first, loaded)
+	}
+
+	all, err := mapper.LoadAll(ctx)
+	if err != nil {
+		t.Fatalf("expected no error on load all but got '%v'", err)
+	}
+
+	if len(all) != 2 || all[0].ID != first.ID || all[1].ID != second.ID {
+		t.Errorf("expected schedules ordered by valid from but got %v", all)
+	}
+
+	if err := mapper.Delete(ctx, first.ID); err != nil {
+		t.Fatalf("expected no error on delete but got '%v'", err)
+	}
+
+	if _, err := mapper.Load(ctx, first.ID); !errors.Is(err, schedulemapper.ErrNotFound) {
+		t.Errorf("expected that schedule was deleted but got error '%v'", err)
+	}
+}
@@ -0,0 +1,31 @@
+package schedulemapper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rebel-l/ttrack_api/schedule/schedulemodel"
+	"github.com/rebel-l/ttrack_api/schedule/schedulestore"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+// LoadAll returns all schedules of the user of the context ordered by valid from.
+func (m *Mapper) LoadAll(ctx context.Context) (schedulemodel.Schedules, error) {
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoadFromDB, err)
+	}
+
+	s := &schedulestore.Schedules{}
+
+	if err := s.Load(ctx, m.db, "user_id = ?", userID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	models := schedulemodel.Schedules{}
+	for _, v := range *s {
+		models = append(models, StoreToModel(v))
+	}
+
+	return models, nil
+}
@@ -0,0 +1,83 @@
+package schedulemapper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/schedule/schedulemapper"
+	"github.com/rebel-l/ttrack_api/schedule/schedulemodel"
+	"github.com/rebel-l/ttrack_api/user/usermapper"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func TestMapper_User(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperUser")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	user, err := usermapper.New(db).Save(context.Background(), &usermodel.User{Name: "Jane"})
+	if err != nil {
+		t.Fatalf("failed to prepare user: %v", err)
+	}
+
+	mapper := schedulemapper.New(db)
+	ctxDefault := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+	ctxUser := usermodel.NewContext(context.Background(), user.ID)
+	schedule := func() *schedulemodel.Schedule {
+		return &schedulemodel.Schedule{ValidFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Monday: 8}
+	}
+
+	own, err := mapper.Save(ctxDefault, schedule())
+	if err != nil {
+		t.Fatalf("failed to prepare schedule of default user: %v", err)
+	}
+
+	// 2. test
+	// the same valid from day doesn't clash with schedules of other users
+	other, err := mapper.Save(ctxUser, schedule())
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	if own.UserID != usermodel.DefaultID || other.UserID != user.ID {
+		t.Errorf("expected owners %s and %s but got %s and %s", usermodel.DefaultID, user.ID, own.UserID, other.UserID)
+	}
+
+	if _, err := mapper.Load(ctxDefault, other.ID); !errors.Is(err, schedulemapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", schedulemapper.ErrNotFound, err)
+	}
+
+	if _, err := mapper.Save(ctxDefault, other); !errors.Is(err, schedulemapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", schedulemapper.ErrNotFound, err)
+	}
+
+	schedules, err := mapper.LoadAll(ctxUser)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if len(schedules) != 1 || schedules[0].ID != other.ID {
+		t.Errorf("expected only schedule %s but got %v", other.ID, schedules)
+	}
+
+	if err := mapper.Delete(ctxDefault, other.ID); !errors.Is(err, schedulemapper.ErrNotFound) {
+		t.Errorf("expected error '%v' on delete but got '%v'", schedulemapper.ErrNotFound, err)
+	}
+
+	if _, err := mapper.Load(ctxUser, other.ID); err != nil {
+		t.Errorf("expected schedule of other user to be kept but got error '%v'", err)
+	}
+}
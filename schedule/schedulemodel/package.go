@@ -0,0 +1,2 @@
+// Package schedulemodel provides functionality and business logic to manage work schedules.
+package schedulemodel
@@ -0,0 +1,107 @@
+package schedulemodel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxHoursPerDay defines the maximum target hours of a single weekday.
+const MaxHoursPerDay = 24
+
+var (
+	// ErrDecodeJSON occurs if a string is not in JSON format.
+	ErrDecodeJSON = errors.New("failed to decode JSON")
+
+	// ErrValidationValidFromMandatory occurs during validation if the valid from date wasn't set.
+	ErrValidationValidFromMandatory = errors.New("valid from should not be empty")
+
+	// ErrValidationValidFromNoDate occurs during validation if the valid from date has a time component.
+	ErrValidationValidFromNoDate = errors.New("valid from should be a date without time")
+
+	// ErrValidationHours occurs during validation if the target hours of a weekday are out of range.
+	ErrValidationHours = errors.New("hours are out of range")
+)
+
+// Schedule represents the target hours per weekday which apply from the valid from date on until the next schedule
+// starts.
+type Schedule struct {
+	ID         uuid.UUID `json:"ID"`
+	UserID     uuid.UUID `json:"UserID"`
+	ValidFrom  time.Time `json:"ValidFrom"`
+	Monday     float64   `json:"Monday"`
+	Tuesday    float64   `json:"Tuesday"`
+	Wednesday  float64   `json:"Wednesday"`
+	Thursday   float64   `json:"Thursday"`
+	Friday     float64   `json:"Friday"`
+	Saturday   float64   `json:"Saturday"`
+	Sunday     float64   `json:"Sunday"`
+	CreatedAt  time.Time `json:"CreatedAt"`
+	ModifiedAt time.Time `json:"ModifiedAt"`
+}
+
+// DecodeJSON converts JSON data to struct.
+func (s *Schedule) DecodeJSON(reader io.Reader) error {
+	if s == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(reader)
+	if err := decoder.Decode(s); err != nil {
+		return fmt.Errorf("%w: %v", ErrDecodeJSON, err)
+	}
+
+	return nil
+}
+
+// Validate is validating the attributes of the struct to valid values. If the validation fails it returns the reason
+// why it failed in the error message.
+func (s *Schedule) Validate() error {
+	if s.ValidFrom.IsZero() {
+		return ErrValidationValidFromMandatory
+	}
+
+	if s.ValidFrom.Hour() != 0 || s.ValidFrom.Minute() != 0 || s.ValidFrom.Second() != 0 ||
+		s.ValidFrom.Nanosecond() != 0 {
+		return ErrValidationValidFromNoDate
+	}
+
+	for _, weekday := range []time.Weekday{
+		time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday,
+	} {
+		if h := s.TargetHours(weekday); h < 0 || h > MaxHoursPerDay {
+			return fmt.Errorf("%w: %s has %v hours", ErrValidationHours, weekday, h)
+		}
+	}
+
+	return nil
+}
+
+// TargetHours returns the hours to work on the given weekday.
+func (s *Schedule) TargetHours(weekday time.Weekday) float64 {
+	switch weekday {
+	case time.Monday:
+		return s.Monday
+	case time.Tuesday:
+		return s.Tuesday
+	case time.Wednesday:
+		return s.Wednesday
+	case time.Thursday:
+		return s.Thursday
+	case time.Friday:
+		return s.Friday
+	case time.Saturday:
+		return s.Saturday
+	default:
+		return s.Sunday
+	}
+}
+
+// WeeklyHours returns the sum of target hours of all weekdays.
+func (s *Schedule) WeeklyHours() float64 {
+	return s.Monday + s.Tuesday + s.Wednesday + s.Thursday + s.Friday + s.Saturday + s.Sunday
+}
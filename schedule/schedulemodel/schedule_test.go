@@ -0,0 +1,111 @@
+package schedulemodel_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/schedule/schedulemodel"
+)
+
+func TestSchedule_Validate(t *testing.T) {
+	t.Parallel()
+
+	validFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		schedule    *schedulemodel.Schedule
+		expectedErr error
+	}{
+		{
+			name:        "valid from missing",
+			schedule:    &schedulemodel.Schedule{Monday: 8},
+			expectedErr: schedulemodel.ErrValidationValidFromMandatory,
+		},
+		{
+			name:        "valid from has time",
+			schedule:    &schedulemodel.Schedule{ValidFrom: validFrom.Add(time.Hour)},
+			expectedErr: schedulemodel.ErrValidationValidFromNoDate,
+		},
+		{
+			name:        "negative hours",
+			schedule:    &schedulemodel.Schedule{ValidFrom: validFrom, Tuesday: -1},
+			expectedErr: schedulemodel.ErrValidationHours,
+		},
+		{
+			name:        "too many hours",
+			schedule:    &schedulemodel.Schedule{ValidFrom: validFrom, Sunday: 24.5},
+			expectedErr: schedulemodel.ErrValidationHours,
+		},
+		{
+			name: "valid",
+			schedule: &schedulemodel.Schedule{
+				ValidFrom: validFrom, Monday: 8, Tuesday: 8, Wednesday: 8, Thursday: 8, Friday: 7.5,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := testCase.schedule.Validate(); !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestSchedule_TargetHours(t *testing.T) {
+	t.Parallel()
+
+	schedule := &schedulemodel.Schedule{
+		Monday: 1, Tuesday: 2, Wednesday: 3, Thursday: 4, Friday: 5, Saturday: 6, Sunday: 7,
+	}
+
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		expected := float64(weekday)
+		if weekday == time.Sunday {
+			expected = 7
+		}
+
+		if actual := schedule.TargetHours(weekday); actual != expected {
+			t.Errorf("expected %v hours on %s but got %v", expected, weekday, actual)
+		}
+	}
+
+	if schedule.WeeklyHours() != 28 {
+		t.Errorf("expected 28 weekly hours but got %v", schedule.WeeklyHours())
+	}
+}
+
+func TestSchedules_For(t *testing.T) {
+	t.Parallel()
+
+	first := &schedulemodel.Schedule{ValidFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	second := &schedulemodel.Schedule{ValidFrom: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)}
+	schedules := schedulemodel.Schedules{second, first}
+
+	testCases := []struct {
+		name     string
+		day      time.Time
+		expected *schedulemodel.Schedule
+	}{
+		{name: "before first", day: time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "first day of first", day: first.ValidFrom, expected: first},
+		{name: "within first", day: time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC), expected: first},
+		{name: "first day of second", day: second.ValidFrom, expected: second},
+		{name: "within second", day: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), expected: second},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := schedules.For(testCase.day); actual != testCase.expected {
+				t.Errorf("expected schedule %v but got %v", testCase.expected, actual)
+			}
+		})
+	}
+}
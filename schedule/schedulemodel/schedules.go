@@ -0,0 +1,24 @@
+package schedulemodel
+
+import "time"
+
+// Schedules is a list of schedules.
+type Schedules []*Schedule
+
+// For returns the schedule valid on the given day, which is the one with the latest valid from date not after the
+// day. If there is none, nil is returned.
+func (s Schedules) For(day time.Time) *Schedule {
+	var found *Schedule
+
+	for _, v := range s {
+		if v.ValidFrom.After(day) {
+			continue
+		}
+
+		if found == nil || v.ValidFrom.After(found.ValidFrom) {
+			found = v
+		}
+	}
+
+	return found
+}
@@ -0,0 +1,2 @@
+// Package schedulestore contains the CRUD operations for the work schedules on the database.
+package schedulestore
@@ -0,0 +1,172 @@
+package schedulestore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+)
+
+const (
+	qSelect = `
+		SELECT id, user_id, valid_from, monday, tuesday, wednesday, thursday, friday, saturday, sunday, created_at,
+			modified_at
+        FROM schedules
+	`
+)
+
+var (
+	// ErrIDMissing will be thrown if an ID is expected but not set.
+	ErrIDMissing = errors.New("id is mandatory for this operation")
+
+	// ErrCreatingID will be thrown if creating an ID failed.
+	ErrCreatingID = errors.New("id creation failed")
+
+	// ErrIDIsSet will be thrown if no ID is expected but already set.
+	ErrIDIsSet = errors.New("id should be not set for this operation, use update instead")
+
+	// ErrDataMissing will be thrown if mandatory data is not set.
+	ErrDataMissing = errors.New("no data or mandatory data missing")
+)
+
+// Schedule represents the work schedule in the database.
+type Schedule struct {
+	ID         uuid.UUID `db:"id"`
+	UserID     uuid.UUID `db:"user_id"`
+	ValidFrom  time.Time `db:"valid_from"`
+	Monday     float64   `db:"monday"`
+	Tuesday    float64   `db:"tuesday"`
+	Wednesday  float64   `db:"wednesday"`
+	Thursday   float64   `db:"thursday"`
+	Friday     float64   `db:"friday"`
+	Saturday   float64   `db:"saturday"`
+	Sunday     float64   `db:"sunday"`
+	CreatedAt  time.Time `db:"created_at"`
+	ModifiedAt time.Time `db:"modified_at"`
+}
+
+// Create creates current object in the database.
+func (s *Schedule) Create(ctx context.Context, db sqlx.ExtContext) error {
+	if !s.IsValid() {
+		return ErrDataMissing
+	}
+
+	if !uuidutils.IsEmpty(s.ID) {
+		return ErrIDIsSet
+	}
+
+	var err error
+
+	s.ID, err = uuid.NewRandom()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCreatingID, err)
+	}
+
+	q := db.Rebind(`
+		INSERT INTO schedules (id, user_id, valid_from, monday, tuesday, wednesday, thursday, friday, saturday, sunday) 
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+	`)
+
+	_, err = db.ExecContext(
+		ctx, q,
+		s.ID, s.UserID, s.ValidFrom, s.Monday, s.Tuesday, s.Wednesday, s.Thursday, s.Friday, s.Saturday, s.Sunday,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create: %w", err)
+	}
+
+	return s.Read(ctx, db)
+}
+
+// Read sets the schedule from database by given ID.
+func (s *Schedule) Read(ctx context.Context, db sqlx.ExtContext) error {
+	if s == nil || uuidutils.IsEmpty(s.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE id = ?;
+    `)
+	if err := sqlx.GetContext(ctx, db, s, q, s.ID); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// Update changes the current object on the database by ID.
+func (s *Schedule) Update(ctx context.Context, db sqlx.ExtContext) error {
+	if !s.IsValid() {
+		return ErrDataMissing
+	}
+
+	if uuidutils.IsEmpty(s.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+		UPDATE schedules 
+		SET valid_from = ?, monday = ?, tuesday = ?, wednesday = ?, thursday = ?, friday = ?, saturday = ?, sunday = ? 
+		WHERE id = ?;
+	`)
+
+	_, err := db.ExecContext(
+		ctx, q, s.ValidFrom, s.Monday, s.Tuesday, s.Wednesday, s.Thursday, s.Friday, s.Saturday, s.Sunday, s.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update: %w", err)
+	}
+
+	return s.Read(ctx, db)
+}
+
+// Delete removes the current object from database by its ID. If the user is set, only a schedule of this user is
+// removed. It returns sql.ErrNoRows if nothing was removed.
+func (s *Schedule) Delete(ctx context.Context, db sqlx.ExtContext) error {
+	if s == nil || uuidutils.IsEmpty(s.ID) {
+		return ErrIDMissing
+	}
+
+	w := "id = ?"
+	args := []any{s.ID}
+
+	if !uuidutils.IsEmpty(s.UserID) {
+		w += " AND user_id = ?"
+		args = append(args, s.UserID)
+	}
+
+	q := db.Rebind(`
+        DELETE FROM schedules
+        WHERE ` + w)
+
+	res, err := db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// IsValid returns true if all mandatory fields are set.
+func (s *Schedule) IsValid() bool {
+	if s == nil || uuidutils.IsEmpty(s.UserID) || s.ValidFrom.IsZero() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,29 @@
+package schedulestore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Schedules is a list of schedules.
+type Schedules []*Schedule
+
+// Load fills the list with the schedules matching the where condition ordered by valid from.
+func (s *Schedules) Load(ctx context.Context, db sqlx.ExtContext, where string, args ...any) error {
+	q := qSelect
+	if where != "" {
+		q += " WHERE " + where
+	}
+	q += " ORDER BY valid_from "
+
+	if err := sqlx.SelectContext(ctx, db, s, db.Rebind(q), args...); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+-- up
+CREATE TABLE IF NOT EXISTS projects (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    customer VARCHAR(100) NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER IF NOT EXISTS projects_after_update AFTER UPDATE ON projects BEGIN
+    UPDATE projects SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+ALTER TABLE timelogs ADD COLUMN project_id CHAR(36) REFERENCES projects(id) ON DELETE SET NULL;
+
+
+-- down
+ALTER TABLE timelogs DROP COLUMN project_id;
+
+DROP TRIGGER IF EXISTS projects_after_update;
+
+DROP TABLE IF EXISTS projects;
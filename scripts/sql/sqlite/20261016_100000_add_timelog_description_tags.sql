@@ -0,0 +1,22 @@
+-- up
+ALTER TABLE timelogs ADD COLUMN description TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS tags (
+    id INTEGER NOT NULL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS timelog_tags (
+    timelog_id CHAR(36) NOT NULL REFERENCES timelogs(id) ON DELETE CASCADE,
+    tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (timelog_id, tag_id)
+);
+
+
+-- down
+DROP TABLE IF EXISTS timelog_tags;
+
+DROP TABLE IF EXISTS tags;
+
+ALTER TABLE timelogs DROP COLUMN description;
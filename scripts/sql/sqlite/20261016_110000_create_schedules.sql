@@ -0,0 +1,24 @@
+-- up
+CREATE TABLE IF NOT EXISTS schedules (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    valid_from DATETIME NOT NULL UNIQUE,
+    monday REAL NOT NULL DEFAULT 0,
+    tuesday REAL NOT NULL DEFAULT 0,
+    wednesday REAL NOT NULL DEFAULT 0,
+    thursday REAL NOT NULL DEFAULT 0,
+    friday REAL NOT NULL DEFAULT 0,
+    saturday REAL NOT NULL DEFAULT 0,
+    sunday REAL NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER IF NOT EXISTS schedules_after_update AFTER UPDATE ON schedules BEGIN
+    UPDATE schedules SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+
+-- down
+DROP TRIGGER IF EXISTS schedules_after_update;
+
+DROP TABLE IF EXISTS schedules;
@@ -0,0 +1,20 @@
+-- up
+CREATE TABLE IF NOT EXISTS vacations (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    year INTEGER NOT NULL UNIQUE,
+    days REAL NOT NULL DEFAULT 0,
+    carry_over REAL NOT NULL DEFAULT 0,
+    carry_over_expires DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER IF NOT EXISTS vacations_after_update AFTER UPDATE ON vacations BEGIN
+    UPDATE vacations SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+
+-- down
+DROP TRIGGER IF EXISTS vacations_after_update;
+
+DROP TABLE IF EXISTS vacations;
@@ -0,0 +1,14 @@
+-- up
+-- keeps only the first public holiday of each day, otherwise the unique index can't be created
+DELETE FROM publicholidays
+WHERE rowid NOT IN (
+    SELECT MIN(rowid)
+    FROM publicholidays
+    GROUP BY substr(day, 1, 10)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS publicholidays_day ON publicholidays (substr(day, 1, 10));
+
+
+-- down
+DROP INDEX IF EXISTS publicholidays_day;
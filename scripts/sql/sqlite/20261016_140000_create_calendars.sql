@@ -0,0 +1,75 @@
+-- up
+CREATE TABLE IF NOT EXISTS calendars (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER IF NOT EXISTS calendars_after_update AFTER UPDATE ON calendars BEGIN
+    UPDATE calendars SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+INSERT INTO calendars (id, name) VALUES ('00000000-0000-0000-0000-000000000001', 'Default');
+
+CREATE TABLE IF NOT EXISTS publicholidays_new (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    calendar_id CHAR(36) NOT NULL REFERENCES calendars(id) ON DELETE CASCADE,
+    day DATETIME NOT NULL,
+    name VARCHAR(250) NOT NULL,
+    halfday INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO publicholidays_new (id, calendar_id, day, name, halfday, created_at, modified_at)
+    SELECT id, '00000000-0000-0000-0000-000000000001', day, name, halfday, created_at, modified_at
+    FROM publicholidays;
+
+DROP TRIGGER IF EXISTS publicholidays_after_update;
+
+DROP INDEX IF EXISTS publicholidays_day;
+
+DROP TABLE publicholidays;
+
+ALTER TABLE publicholidays_new RENAME TO publicholidays;
+
+CREATE UNIQUE INDEX IF NOT EXISTS publicholidays_calendar_day ON publicholidays (calendar_id, substr(day, 1, 10));
+
+CREATE TRIGGER IF NOT EXISTS publicholidays_after_update AFTER UPDATE ON publicholidays BEGIN
+    UPDATE publicholidays SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+
+-- down
+CREATE TABLE IF NOT EXISTS publicholidays_old (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    day DATETIME NOT NULL,
+    name VARCHAR(250) NOT NULL,
+    halfday INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO publicholidays_old (id, day, name, halfday, created_at, modified_at)
+    SELECT id, day, name, halfday, created_at, modified_at
+    FROM publicholidays
+    WHERE calendar_id = '00000000-0000-0000-0000-000000000001';
+
+DROP TRIGGER IF EXISTS publicholidays_after_update;
+
+DROP INDEX IF EXISTS publicholidays_calendar_day;
+
+DROP TABLE publicholidays;
+
+ALTER TABLE publicholidays_old RENAME TO publicholidays;
+
+CREATE UNIQUE INDEX IF NOT EXISTS publicholidays_day ON publicholidays (substr(day, 1, 10));
+
+CREATE TRIGGER IF NOT EXISTS publicholidays_after_update AFTER UPDATE ON publicholidays BEGIN
+    UPDATE publicholidays SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+DROP TRIGGER IF EXISTS calendars_after_update;
+
+DROP TABLE IF EXISTS calendars;
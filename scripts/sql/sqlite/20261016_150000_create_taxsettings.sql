@@ -0,0 +1,19 @@
+-- up
+CREATE TABLE IF NOT EXISTS taxsettings (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    year INTEGER NOT NULL UNIQUE,
+    homeoffice_allowance REAL NOT NULL DEFAULT 0,
+    homeoffice_cap REAL NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER IF NOT EXISTS taxsettings_after_update AFTER UPDATE ON taxsettings BEGIN
+    UPDATE taxsettings SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+
+-- down
+DROP TRIGGER IF EXISTS taxsettings_after_update;
+
+DROP TABLE IF EXISTS taxsettings;
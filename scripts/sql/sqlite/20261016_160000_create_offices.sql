@@ -0,0 +1,39 @@
+-- up
+CREATE TABLE IF NOT EXISTS offices (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    distance REAL NOT NULL DEFAULT 0,
+    is_default INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER IF NOT EXISTS offices_after_update AFTER UPDATE ON offices BEGIN
+    UPDATE offices SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+ALTER TABLE timelogs ADD COLUMN office_id CHAR(36) REFERENCES offices(id) ON DELETE SET NULL;
+
+ALTER TABLE taxsettings ADD COLUMN commute_rate REAL NOT NULL DEFAULT 0.3;
+
+ALTER TABLE taxsettings ADD COLUMN commute_rate_long_distance REAL NOT NULL DEFAULT 0.38;
+
+ALTER TABLE taxsettings ADD COLUMN commute_long_distance_from REAL NOT NULL DEFAULT 20;
+
+ALTER TABLE taxsettings ADD COLUMN reimbursement_rate REAL NOT NULL DEFAULT 0.3;
+
+
+-- down
+ALTER TABLE taxsettings DROP COLUMN reimbursement_rate;
+
+ALTER TABLE taxsettings DROP COLUMN commute_long_distance_from;
+
+ALTER TABLE taxsettings DROP COLUMN commute_rate_long_distance;
+
+ALTER TABLE taxsettings DROP COLUMN commute_rate;
+
+ALTER TABLE timelogs DROP COLUMN office_id;
+
+DROP TRIGGER IF EXISTS offices_after_update;
+
+DROP TABLE IF EXISTS offices;
@@ -0,0 +1,29 @@
+-- up
+CREATE TABLE IF NOT EXISTS users (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER IF NOT EXISTS users_after_update AFTER UPDATE ON users BEGIN
+    UPDATE users SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+INSERT INTO users (id, name) VALUES ('00000000-0000-0000-0000-000000000001', 'Default');
+
+ALTER TABLE timelogs ADD COLUMN user_id CHAR(36) REFERENCES users(id);
+
+UPDATE timelogs SET user_id = '00000000-0000-0000-0000-000000000001';
+
+CREATE INDEX IF NOT EXISTS timelogs_user_id ON timelogs (user_id);
+
+
+-- down
+DROP INDEX IF EXISTS timelogs_user_id;
+
+ALTER TABLE timelogs DROP COLUMN user_id;
+
+DROP TRIGGER IF EXISTS users_after_update;
+
+DROP TABLE IF EXISTS users;
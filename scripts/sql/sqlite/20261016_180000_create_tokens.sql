@@ -0,0 +1,20 @@
+-- up
+CREATE TABLE IF NOT EXISTS tokens (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    user_id CHAR(36) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    hash CHAR(64) NOT NULL UNIQUE,
+    expires_at DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER IF NOT EXISTS tokens_after_update AFTER UPDATE ON tokens BEGIN
+    UPDATE tokens SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+
+-- down
+DROP TRIGGER IF EXISTS tokens_after_update;
+
+DROP TABLE IF EXISTS tokens;
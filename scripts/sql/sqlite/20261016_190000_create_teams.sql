@@ -0,0 +1,31 @@
+-- up
+CREATE TABLE IF NOT EXISTS teams (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER IF NOT EXISTS teams_after_update AFTER UPDATE ON teams BEGIN
+    UPDATE teams SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+ALTER TABLE users ADD COLUMN role VARCHAR(10) NOT NULL DEFAULT 'member';
+
+ALTER TABLE users ADD COLUMN team_id CHAR(36) REFERENCES teams(id) ON DELETE SET NULL;
+
+UPDATE users SET role = 'admin' WHERE id = '00000000-0000-0000-0000-000000000001';
+
+CREATE INDEX IF NOT EXISTS users_team_id ON users (team_id);
+
+
+-- down
+DROP INDEX IF EXISTS users_team_id;
+
+ALTER TABLE users DROP COLUMN team_id;
+
+ALTER TABLE users DROP COLUMN role;
+
+DROP TRIGGER IF EXISTS teams_after_update;
+
+DROP TABLE IF EXISTS teams;
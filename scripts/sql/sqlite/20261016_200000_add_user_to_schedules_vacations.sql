@@ -0,0 +1,117 @@
+-- up
+CREATE TABLE IF NOT EXISTS schedules_per_user (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    user_id CHAR(36) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    valid_from DATETIME NOT NULL,
+    monday REAL NOT NULL DEFAULT 0,
+    tuesday REAL NOT NULL DEFAULT 0,
+    wednesday REAL NOT NULL DEFAULT 0,
+    thursday REAL NOT NULL DEFAULT 0,
+    friday REAL NOT NULL DEFAULT 0,
+    saturday REAL NOT NULL DEFAULT 0,
+    sunday REAL NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, valid_from)
+);
+
+INSERT INTO schedules_per_user (
+    id, user_id, valid_from, monday, tuesday, wednesday, thursday, friday, saturday, sunday, created_at, modified_at
+)
+SELECT id, '00000000-0000-0000-0000-000000000001', valid_from, monday, tuesday, wednesday, thursday, friday, saturday,
+       sunday, created_at, modified_at
+FROM schedules;
+
+DROP TRIGGER IF EXISTS schedules_after_update;
+
+DROP TABLE IF EXISTS schedules;
+
+ALTER TABLE schedules_per_user RENAME TO schedules;
+
+CREATE TRIGGER IF NOT EXISTS schedules_after_update AFTER UPDATE ON schedules BEGIN
+    UPDATE schedules SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+CREATE TABLE IF NOT EXISTS vacations_per_user (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    user_id CHAR(36) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    year INTEGER NOT NULL,
+    days REAL NOT NULL DEFAULT 0,
+    carry_over REAL NOT NULL DEFAULT 0,
+    carry_over_expires DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, year)
+);
+
+INSERT INTO vacations_per_user (id, user_id, year, days, carry_over, carry_over_expires, created_at, modified_at)
+SELECT id, '00000000-0000-0000-0000-000000000001', year, days, carry_over, carry_over_expires, created_at, modified_at
+FROM vacations;
+
+DROP TRIGGER IF EXISTS vacations_after_update;
+
+DROP TABLE IF EXISTS vacations;
+
+ALTER TABLE vacations_per_user RENAME TO vacations;
+
+CREATE TRIGGER IF NOT EXISTS vacations_after_update AFTER UPDATE ON vacations BEGIN
+    UPDATE vacations SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+
+-- down
+CREATE TABLE IF NOT EXISTS vacations_of_all (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    year INTEGER NOT NULL UNIQUE,
+    days REAL NOT NULL DEFAULT 0,
+    carry_over REAL NOT NULL DEFAULT 0,
+    carry_over_expires DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO vacations_of_all (id, year, days, carry_over, carry_over_expires, created_at, modified_at)
+SELECT id, year, days, carry_over, carry_over_expires, created_at, modified_at
+FROM vacations
+WHERE user_id = '00000000-0000-0000-0000-000000000001';
+
+DROP TRIGGER IF EXISTS vacations_after_update;
+
+DROP TABLE IF EXISTS vacations;
+
+ALTER TABLE vacations_of_all RENAME TO vacations;
+
+CREATE TRIGGER IF NOT EXISTS vacations_after_update AFTER UPDATE ON vacations BEGIN
+    UPDATE vacations SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
+
+CREATE TABLE IF NOT EXISTS schedules_of_all (
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    valid_from DATETIME NOT NULL UNIQUE,
+    monday REAL NOT NULL DEFAULT 0,
+    tuesday REAL NOT NULL DEFAULT 0,
+    wednesday REAL NOT NULL DEFAULT 0,
+    thursday REAL NOT NULL DEFAULT 0,
+    friday REAL NOT NULL DEFAULT 0,
+    saturday REAL NOT NULL DEFAULT 0,
+    sunday REAL NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO schedules_of_all (
+    id, valid_from, monday, tuesday, wednesday, thursday, friday, saturday, sunday, created_at, modified_at
+)
+SELECT id, valid_from, monday, tuesday, wednesday, thursday, friday, saturday, sunday, created_at, modified_at
+FROM schedules
+WHERE user_id = '00000000-0000-0000-0000-000000000001';
+
+DROP TRIGGER IF EXISTS schedules_after_update;
+
+DROP TABLE IF EXISTS schedules;
+
+ALTER TABLE schedules_of_all RENAME TO schedules;
+
+CREATE TRIGGER IF NOT EXISTS schedules_after_update AFTER UPDATE ON schedules BEGIN
+    UPDATE schedules SET modified_at = DATETIME('now') WHERE id = NEW.id;
+end;
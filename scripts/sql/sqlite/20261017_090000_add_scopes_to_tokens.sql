@@ -0,0 +1,6 @@
+-- up
+ALTER TABLE tokens ADD COLUMN scopes TEXT;
+
+
+-- down
+ALTER TABLE tokens DROP COLUMN scopes;
@@ -0,0 +1,2 @@
+// Package taxmapper provides functionality to read and persist the yearly tax settings.
+package taxmapper
@@ -0,0 +1,151 @@
+package taxmapper
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+	"github.com/rebel-l/ttrack_api/tax/taxmodel"
+	"github.com/rebel-l/ttrack_api/tax/taxstore"
+)
+
+var (
+	// ErrLoadFromDB occurs if something went wrong on loading.
+	ErrLoadFromDB = errors.New("failed to load tax setting from database")
+
+	// ErrNoData occurs if given model is nil.
+	ErrNoData = errors.New("tax setting is nil")
+
+	// ErrSaveToDB occurs if something went wrong on saving.
+	ErrSaveToDB = errors.New("failed to save tax setting to database")
+
+	// ErrNotFound occurs if record doesn't exist in database.
+	ErrNotFound = errors.New("tax setting was not found")
+
+	// ErrDuplicateYear occurs if another setting exists for the same year.
+	ErrDuplicateYear = errors.New("there is already a tax setting for this year")
+)
+
+// Mapper provides methods to load and persist tax setting models.
+type Mapper struct {
+	db *sqlx.DB
+}
+
+// New returns a new mapper.
+func New(db *sqlx.DB) *Mapper {
+	return &Mapper{db: db}
+}
+
+// LoadByYear returns the setting of the given year.
+func (m *Mapper) LoadByYear(ctx context.Context, year int) (*taxmodel.Setting, error) {
+	s := &taxstore.Setting{Year: year} // nolint: exhaustivestruct
+
+	if err := s.ReadByYear(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// LoadByYearOrDefault returns the setting of the given year. If there is no setting stored for the year, the default
+// setting is returned.
+func (m *Mapper) LoadByYearOrDefault(ctx context.Context, year int) (*taxmodel.Setting, error) {
+	setting, err := m.LoadByYear(ctx, year)
+	if errors.Is(err, ErrNotFound) {
+		return taxmodel.NewSetting(year), nil
+	}
+
+	return setting, err
+}
+
+// Save persists (create or update) the model and returns the changed data (id, createdAt or modifiedAt). There is
+// only one setting per year, so a model without ID replaces an existing setting of the same year. A model with ID
+// fails with ErrNotFound if it doesn't exist and with ErrDuplicateYear if another setting has the same year.
+func (m *Mapper) Save(ctx context.Context, model *taxmodel.Setting) (*taxmodel.Setting, error) {
+	if model == nil {
+		return nil, ErrNoData
+	}
+
+	s := modelToStore(model)
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	existing := &taxstore.Setting{Year: s.Year} // nolint: exhaustivestruct
+	if err := existing.ReadByYear(ctx, tx); errors.Is(err, sql.ErrNoRows) {
+		existing.ID = uuid.Nil
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	switch {
+	case uuidutils.IsEmpty(s.ID):
+		s.ID = existing.ID
+	case !uuidutils.IsEmpty(existing.ID) && existing.ID != s.ID:
+		return nil, fmt.Errorf("%w: %d", ErrDuplicateYear, s.Year)
+	}
+
+	if uuidutils.IsEmpty(s.ID) {
+		if err := s.Create(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	} else {
+		if err := s.Update(ctx, tx); errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// StoreToModel returns a model based on the given store object. It maps all properties from store to model.
+func StoreToModel(s *taxstore.Setting) *taxmodel.Setting {
+	if s == nil {
+		return &taxmodel.Setting{} // nolint: exhaustivestruct
+	}
+
+	return &taxmodel.Setting{
+		ID:                      s.ID,
+		Year:                    s.Year,
+		HomeOfficeAllowance:     s.HomeOfficeAllowance,
+		HomeOfficeCap:           s.HomeOfficeCap,
+		CommuteRate:             s.CommuteRate,
+		CommuteRateLongDistance: s.CommuteRateLongDistance,
+		CommuteLongDistanceFrom: s.CommuteLongDistanceFrom,
+		ReimbursementRate:       s.ReimbursementRate,
+		CreatedAt:               s.CreatedAt,
+		ModifiedAt:              s.ModifiedAt,
+	}
+}
+
+// modelToStore returns a store based on the given model object. It maps all properties from model to store.
+func modelToStore(m *taxmodel.Setting) *taxstore.Setting {
+	return &taxstore.Setting{
+		ID:                      m.ID,
+		Year:                    m.Year,
+		HomeOfficeAllowance:     m.HomeOfficeAllowance,
+		HomeOfficeCap:           m.HomeOfficeCap,
+		CommuteRate:             m.CommuteRate,
+		CommuteRateLongDistance: m.CommuteRateLongDistance,
+		CommuteLongDistanceFrom: m.CommuteLongDistanceFrom,
+		ReimbursementRate:       m.ReimbursementRate,
+		CreatedAt:               m.CreatedAt,
+		ModifiedAt:              m.ModifiedAt,
+	}
+}
@@ -0,0 +1,131 @@
+package taxmapper_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rebel-l/go-utils/osutils"
+	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/config"
+	"github.com/rebel-l/ttrack_api/tax/taxmapper"
+	"github.com/rebel-l/ttrack_api/tax/taxmodel"
+)
+
+func setup(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+
+	// 0. init path
+	storagePath := filepath.Join(".", "..", "..", "storage", "test_tax", name)
+	scriptPath := filepath.Join(".", "..", "..", "scripts", "sql", "sqlite")
+	conf := &config.Database{
+		StoragePath:       &storagePath,
+		SchemaScriptsPath: &scriptPath,
+	}
+
+	// 1. clean up
+	if osutils.FileOrPathExists(conf.GetStoragePath()) {
+		if err := os.RemoveAll(conf.GetStoragePath()); err != nil {
+			t.Fatalf("failed to cleanup test files: %v", err)
+		}
+	}
+
+	// 2. init database
+	db, err := bootstrap.Database(conf, "0.0.0", false)
+	if err != nil {
+		t.Fatalf("No error expected: %v", err)
+	}
+
+	return db
+}
+
+func TestMapper_SaveLoad(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperSaveLoad")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := taxmapper.New(db)
+	ctx := context.Background()
+
+	// 2. test
+	if _, err := mapper.Save(ctx, nil); !errors.Is(err, taxmapper.ErrNoData) {
+		t.Errorf("expected error '%v' but got '%v'", taxmapper.ErrNoData, err)
+	}
+
+	if _, err := mapper.LoadByYear(ctx, 2024); !errors.Is(err, taxmapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", taxmapper.ErrNotFound, err)
+	}
+
+	defaults, err := mapper.LoadByYearOrDefault(ctx, 2024)
+	if err != nil {
+		t.Fatalf("expected no error on load default but got '%v'", err)
+	}
+
+	if *defaults != *taxmodel.NewSetting(2024) {
+		t.Errorf("expected default setting '%+v' but got '%+v'", taxmodel.NewSetting(2024), defaults)
+	}
+
+	saved, err := mapper.Save(ctx, &taxmodel.Setting{Year: 2024, HomeOfficeAllowance: 5, HomeOfficeCap: 600})
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	// without ID the setting of the same year is replaced
+	replaced, err := mapper.Save(ctx, &taxmodel.Setting{
+		Year:                    2024,
+		HomeOfficeAllowance:     6,
+		HomeOfficeCap:           1260,
+		CommuteRate:             0.3,
+		CommuteRateLongDistance: 0.38,
+		CommuteLongDistanceFrom: 20,
+		ReimbursementRate:       0.25,
+	})
+	if err != nil {
+		t.Fatalf("expected no error on replace but got '%v'", err)
+	}
+
+	if replaced.ID != saved.ID {
+		t.Errorf("expected setting %s to be replaced but got new one %s", saved.ID, replaced.ID)
+	}
+
+	loaded, err := mapper.LoadByYearOrDefault(ctx, 2024)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if loaded.ID != saved.ID || loaded.HomeOfficeAllowance != 6 || loaded.HomeOfficeCap != 1260 ||
+		loaded.CommuteRateLongDistance != 0.38 || loaded.ReimbursementRate != 0.25 {
+		t.Errorf("expected setting '%+v' but got '%+v'", replaced, loaded)
+	}
+
+	other, err := mapper.Save(ctx, &taxmodel.Setting{Year: 2025})
+	if err != nil {
+		t.Fatalf("expected no error on save of another year but got '%v'", err)
+	}
+
+	other.Year = 2024
+	if _, err := mapper.Save(ctx, other); !errors.Is(err, taxmapper.ErrDuplicateYear) {
+		t.Errorf("expected error '%v' but got '%v'", taxmapper.ErrDuplicateYear, err)
+	}
+
+	unknown := &taxmodel.Setting{ID: uuid.New(), Year: 2026}
+	if _, err := mapper.Save(ctx, unknown); !errors.Is(err, taxmapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", taxmapper.ErrNotFound, err)
+	}
+}
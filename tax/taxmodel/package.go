@@ -0,0 +1,2 @@
+// Package taxmodel provides functionality and business logic to manage the yearly tax settings.
+package taxmodel
@@ -0,0 +1,122 @@
+package taxmodel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultHomeOfficeAllowance defines the amount deductible per day worked exclusively from home, if nothing else is
+	// configured for a year.
+	DefaultHomeOfficeAllowance = 6
+
+	// DefaultHomeOfficeCap defines the maximum amount deductible for home office per year, if nothing else is
+	// configured for a year.
+	DefaultHomeOfficeCap = 1260
+
+	// DefaultCommuteRate defines the amount deductible per kilometre of the one-way distance to the office, if nothing
+	// else is configured for a year.
+	DefaultCommuteRate = 0.3
+
+	// DefaultCommuteRateLongDistance defines the amount deductible per kilometre beyond DefaultCommuteLongDistanceFrom,
+	// if nothing else is configured for a year.
+	DefaultCommuteRateLongDistance = 0.38
+
+	// DefaultCommuteLongDistanceFrom defines the kilometres of the one-way distance after which the long distance rate
+	// applies, if nothing else is configured for a year.
+	DefaultCommuteLongDistanceFrom = 20
+
+	// DefaultReimbursementRate defines the amount the company reimburses per kilometre driven, if nothing else is
+	// configured for a year.
+	DefaultReimbursementRate = 0.3
+)
+
+var (
+	// ErrDecodeJSON occurs if a string is not in JSON format.
+	ErrDecodeJSON = errors.New("failed to decode JSON")
+
+	// ErrValidationYearMandatory occurs during validation if the year wasn't set.
+	ErrValidationYearMandatory = errors.New("year should not be empty")
+
+	// ErrValidationAmount occurs during validation if an amount is negative.
+	ErrValidationAmount = errors.New("amount should not be negative")
+)
+
+// Setting represents the tax rules of a year. HomeOfficeAllowance is the amount deductible per day worked exclusively
+// from home, HomeOfficeCap the maximum amount deductible for the whole year. The commute deduction per office day is
+// the one-way distance multiplied by CommuteRate, kilometres beyond CommuteLongDistanceFrom by
+// CommuteRateLongDistance. ReimbursementRate is the amount the company pays per kilometre driven to and from the
+// office.
+type Setting struct {
+	ID                      uuid.UUID `json:"ID"`
+	Year                    int       `json:"Year"`
+	HomeOfficeAllowance     float64   `json:"HomeOfficeAllowance"`
+	HomeOfficeCap           float64   `json:"HomeOfficeCap"`
+	CommuteRate             float64   `json:"CommuteRate"`
+	CommuteRateLongDistance float64   `json:"CommuteRateLongDistance"`
+	CommuteLongDistanceFrom float64   `json:"CommuteLongDistanceFrom"`
+	ReimbursementRate       float64   `json:"ReimbursementRate"`
+	CreatedAt               time.Time `json:"CreatedAt"`
+	ModifiedAt              time.Time `json:"ModifiedAt"`
+}
+
+// NewSetting returns the default tax setting for the given year. It is used if no setting is stored for the year.
+func NewSetting(year int) *Setting {
+	return &Setting{ // nolint: exhaustivestruct
+		Year:                    year,
+		HomeOfficeAllowance:     DefaultHomeOfficeAllowance,
+		HomeOfficeCap:           DefaultHomeOfficeCap,
+		CommuteRate:             DefaultCommuteRate,
+		CommuteRateLongDistance: DefaultCommuteRateLongDistance,
+		CommuteLongDistanceFrom: DefaultCommuteLongDistanceFrom,
+		ReimbursementRate:       DefaultReimbursementRate,
+	}
+}
+
+// DecodeJSON converts JSON data to struct.
+func (s *Setting) DecodeJSON(reader io.Reader) error {
+	if s == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(reader)
+	if err := decoder.Decode(s); err != nil {
+		return fmt.Errorf("%w: %v", ErrDecodeJSON, err)
+	}
+
+	return nil
+}
+
+// Validate is validating the attributes of the struct to valid values. If the validation fails it returns the reason
+// why it failed in the error message.
+func (s *Setting) Validate() error {
+	if s.Year <= 0 {
+		return ErrValidationYearMandatory
+	}
+
+	if s.HomeOfficeAllowance < 0 {
+		return fmt.Errorf("%w: home office allowance has %v", ErrValidationAmount, s.HomeOfficeAllowance)
+	}
+
+	if s.HomeOfficeCap < 0 {
+		return fmt.Errorf("%w: home office cap has %v", ErrValidationAmount, s.HomeOfficeCap)
+	}
+
+	for name, v := range map[string]float64{
+		"commute rate":               s.CommuteRate,
+		"commute rate long distance": s.CommuteRateLongDistance,
+		"commute long distance from": s.CommuteLongDistanceFrom,
+		"reimbursement rate":         s.ReimbursementRate,
+	} {
+		if v < 0 {
+			return fmt.Errorf("%w: %s has %v", ErrValidationAmount, name, v)
+		}
+	}
+
+	return nil
+}
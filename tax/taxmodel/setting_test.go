@@ -0,0 +1,62 @@
+package taxmodel_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rebel-l/ttrack_api/tax/taxmodel"
+)
+
+func TestSetting_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		setting     *taxmodel.Setting
+		expectedErr error
+	}{
+		{
+			name:        "year missing",
+			setting:     &taxmodel.Setting{HomeOfficeAllowance: 6},
+			expectedErr: taxmodel.ErrValidationYearMandatory,
+		},
+		{
+			name:        "negative allowance",
+			setting:     &taxmodel.Setting{Year: 2024, HomeOfficeAllowance: -1},
+			expectedErr: taxmodel.ErrValidationAmount,
+		},
+		{
+			name:        "negative cap",
+			setting:     &taxmodel.Setting{Year: 2024, HomeOfficeAllowance: 6, HomeOfficeCap: -1},
+			expectedErr: taxmodel.ErrValidationAmount,
+		},
+		{
+			name:        "negative commute rate",
+			setting:     &taxmodel.Setting{Year: 2024, CommuteRate: -0.3},
+			expectedErr: taxmodel.ErrValidationAmount,
+		},
+		{
+			name:        "negative reimbursement rate",
+			setting:     &taxmodel.Setting{Year: 2024, ReimbursementRate: -0.3},
+			expectedErr: taxmodel.ErrValidationAmount,
+		},
+		{
+			name:    "valid",
+			setting: &taxmodel.Setting{Year: 2024, HomeOfficeAllowance: 6, HomeOfficeCap: 1260},
+		},
+		{
+			name:    "default",
+			setting: taxmodel.NewSetting(2024),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := testCase.setting.Validate(); !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,2 @@
+// Package taxstore contains the CRUD operations for the yearly tax settings on the database.
+package taxstore
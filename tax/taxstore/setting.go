@@ -0,0 +1,184 @@
+package taxstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+)
+
+const (
+	qSelect = `
+		SELECT id, year, homeoffice_allowance, homeoffice_cap, commute_rate, commute_rate_long_distance,
+			commute_long_distance_from, reimbursement_rate, created_at, modified_at
+        FROM taxsettings
+	`
+)
+
+var (
+	// ErrIDMissing will be thrown if an ID is expected but not set.
+	ErrIDMissing = errors.New("id is mandatory for this operation")
+
+	// ErrCreatingID will be thrown if creating an ID failed.
+	ErrCreatingID = errors.New("id creation failed")
+
+	// ErrIDIsSet will be thrown if no ID is expected but already set.
+	ErrIDIsSet = errors.New("id should be not set for this operation, use update instead")
+
+	// ErrDataMissing will be thrown if mandatory data is not set.
+	ErrDataMissing = errors.New("no data or mandatory data missing")
+)
+
+// Setting represents the tax setting of a year in the database.
+type Setting struct {
+	ID                      uuid.UUID `db:"id"`
+	Year                    int       `db:"year"`
+	HomeOfficeAllowance     float64   `db:"homeoffice_allowance"`
+	HomeOfficeCap           float64   `db:"homeoffice_cap"`
+	CommuteRate             float64   `db:"commute_rate"`
+	CommuteRateLongDistance float64   `db:"commute_rate_long_distance"`
+	CommuteLongDistanceFrom float64   `db:"commute_long_distance_from"`
+	ReimbursementRate       float64   `db:"reimbursement_rate"`
+	CreatedAt               time.Time `db:"created_at"`
+	ModifiedAt              time.Time `db:"modified_at"`
+}
+
+// Create creates current object in the database.
+func (s *Setting) Create(ctx context.Context, db sqlx.ExtContext) error {
+	if !s.IsValid() {
+		return ErrDataMissing
+	}
+
+	if !uuidutils.IsEmpty(s.ID) {
+		return ErrIDIsSet
+	}
+
+	var err error
+
+	s.ID, err = uuid.NewRandom()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCreatingID, err)
+	}
+
+	q := db.Rebind(`
+		INSERT INTO taxsettings (
+			id, year, homeoffice_allowance, homeoffice_cap, commute_rate, commute_rate_long_distance,
+			commute_long_distance_from, reimbursement_rate
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+	`)
+
+	_, err = db.ExecContext(
+		ctx, q, s.ID, s.Year, s.HomeOfficeAllowance, s.HomeOfficeCap, s.CommuteRate, s.CommuteRateLongDistance,
+		s.CommuteLongDistanceFrom, s.ReimbursementRate,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create: %w", err)
+	}
+
+	return s.Read(ctx, db)
+}
+
+// Read sets the setting from database by given ID.
+func (s *Setting) Read(ctx context.Context, db sqlx.ExtContext) error {
+	if s == nil || uuidutils.IsEmpty(s.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE id = ?;
+    `)
+	if err := sqlx.GetContext(ctx, db, s, q, s.ID); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// ReadByYear sets the setting from database by given year.
+func (s *Setting) ReadByYear(ctx context.Context, db sqlx.ExtContext) error {
+	if s == nil || s.Year <= 0 {
+		return ErrDataMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE year = ?;
+    `)
+	if err := sqlx.GetContext(ctx, db, s, q, s.Year); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// Update changes the current object on the database by ID. It returns sql.ErrNoRows if there is no setting with the
+// ID.
+func (s *Setting) Update(ctx context.Context, db sqlx.ExtContext) error {
+	if !s.IsValid() {
+		return ErrDataMissing
+	}
+
+	if uuidutils.IsEmpty(s.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+		UPDATE taxsettings
+		SET year = ?, homeoffice_allowance = ?, homeoffice_cap = ?, commute_rate = ?, commute_rate_long_distance = ?,
+			commute_long_distance_from = ?, reimbursement_rate = ?
+		WHERE id = ?;
+	`)
+
+	res, err := db.ExecContext(
+		ctx, q, s.Year, s.HomeOfficeAllowance, s.HomeOfficeCap, s.CommuteRate, s.CommuteRateLongDistance,
+		s.CommuteLongDistanceFrom, s.ReimbursementRate, s.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update: %w", err)
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return s.Read(ctx, db)
+}
+
+// Delete removes the current object from database by its ID.
+func (s *Setting) Delete(ctx context.Context, db sqlx.ExtContext) error {
+	if s == nil || uuidutils.IsEmpty(s.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+        DELETE FROM taxsettings
+        WHERE id = ?
+    `)
+
+	if _, err := db.ExecContext(ctx, q, s.ID); err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	return nil
+}
+
+// IsValid returns true if all mandatory fields are set.
+func (s *Setting) IsValid() bool {
+	if s == nil || s.Year <= 0 {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,2 @@
+// Package teammapper provides functionality to read and persist teams.
+package teammapper
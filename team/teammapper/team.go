@@ -0,0 +1,134 @@
+package teammapper
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+	"github.com/rebel-l/ttrack_api/team/teammodel"
+	"github.com/rebel-l/ttrack_api/team/teamstore"
+)
+
+var (
+	// ErrLoadFromDB occurs if something went wrong on loading.
+	ErrLoadFromDB = errors.New("failed to load team from database")
+
+	// ErrNoData occurs if given model is nil.
+	ErrNoData = errors.New("team is nil")
+
+	// ErrSaveToDB occurs if something went wrong on saving.
+	ErrSaveToDB = errors.New("failed to save team to database")
+
+	// ErrDeleteFromDB occurs if something went wrong on deleting.
+	ErrDeleteFromDB = errors.New("failed to delete team from database")
+
+	// ErrNotFound occurs if record doesn't exist in database.
+	ErrNotFound = errors.New("team was not found")
+
+	// ErrNameExists occurs if there is already another team with the same name.
+	ErrNameExists = errors.New("there is already a team with this name")
+)
+
+// Mapper provides methods to load and persist team models.
+type Mapper struct {
+	db *sqlx.DB
+}
+
+// New returns a new mapper.
+func New(db *sqlx.DB) *Mapper {
+	return &Mapper{db: db}
+}
+
+// Load returns a team model loaded from database by ID.
+func (m *Mapper) Load(ctx context.Context, id uuid.UUID) (*teammodel.Team, error) {
+	s := &teamstore.Team{ID: id} // nolint: exhaustivestruct
+
+	if err := s.Read(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// Save persists (create or update) the model and returns the changed data (id, createdAt or modifiedAt). It fails
+// with ErrNameExists if there is another team with the same name.
+func (m *Mapper) Save(ctx context.Context, model *teammodel.Team) (*teammodel.Team, error) {
+	if model == nil {
+		return nil, ErrNoData
+	}
+
+	s := modelToStore(model)
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	existing := &teamstore.Teams{}
+	if err := existing.Load(ctx, tx, "name = ? AND id != ?", s.Name, s.ID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	if len(*existing) > 0 {
+		return nil, ErrNameExists
+	}
+
+	if uuidutils.IsEmpty(model.ID) {
+		if err := s.Create(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	} else {
+		if err := s.Update(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// Delete removes a model from database by ID. Members of the team are kept without team.
+func (m *Mapper) Delete(ctx context.Context, id uuid.UUID) error {
+	s := &teamstore.Team{ID: id} // nolint: exhaustivestruct
+
+	if err := s.Delete(ctx, m.db); err != nil {
+		return fmt.Errorf("%w: %v", ErrDeleteFromDB, err)
+	}
+
+	return nil
+}
+
+// StoreToModel returns a model based on the given store object. It maps all properties from store to model.
+func StoreToModel(s *teamstore.Team) *teammodel.Team {
+	if s == nil {
+		return &teammodel.Team{} // nolint: exhaustivestruct
+	}
+
+	return &teammodel.Team{
+		ID:         s.ID,
+		Name:       s.Name,
+		CreatedAt:  s.CreatedAt,
+		ModifiedAt: s.ModifiedAt,
+	}
+}
+
+// modelToStore returns a store based on the given model object. It maps all properties from model to store.
+func modelToStore(m *teammodel.Team) *teamstore.Team {
+	return &teamstore.Team{
+		ID:         m.ID,
+		Name:       m.Name,
+		CreatedAt:  m.CreatedAt,
+		ModifiedAt: m.ModifiedAt,
+	}
+}
@@ -0,0 +1,160 @@
+package teammapper_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rebel-l/go-utils/osutils"
+	"github.com/rebel-l/go-utils/testingutils"
+	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/config"
+	"github.com/rebel-l/ttrack_api/team/teammapper"
+	"github.com/rebel-l/ttrack_api/team/teammodel"
+	"github.com/rebel-l/ttrack_api/user/usermapper"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func setup(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+
+	// 0. init path
+	storagePath := filepath.Join(".", "..", "..", "storage", "test_team", name)
+	scriptPath := filepath.Join(".", "..", "..", "scripts", "sql", "sqlite")
+	conf := &config.Database{
+		StoragePath:       &storagePath,
+		SchemaScriptsPath: &scriptPath,
+	}
+
+	// 1. clean up
+	if osutils.FileOrPathExists(conf.GetStoragePath()) {
+		if err := os.RemoveAll(conf.GetStoragePath()); err != nil {
+			t.Fatalf("failed to cleanup test files: %v", err)
+		}
+	}
+
+	// 2. init database
+	db, err := bootstrap.Database(conf, "0.0.0", false)
+	if err != nil {
+		t.Fatalf("No error expected: %v", err)
+	}
+
+	return db
+}
+
+func TestMapper_SaveLoad(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperSaveLoad")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := teammapper.New(db)
+	ctx := context.Background()
+
+	// 2. test
+	if _, err := mapper.Save(ctx, nil); !errors.Is(err, teammapper.ErrNoData) {
+		t.Errorf("expected error '%v' but got '%v'", teammapper.ErrNoData, err)
+	}
+
+	notExisting := testingutils.UUIDParse(t, "5f0d3c1e-7b1a-4c8e-9d2f-6a5b4c3d2e1f")
+	if _, err := mapper.Load(ctx, notExisting); !errors.Is(err, teammapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", teammapper.ErrNotFound, err)
+	}
+
+	saved, err := mapper.Save(ctx, &teammodel.Team{Name: "Backend"})
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	if _, err := mapper.Save(ctx, &teammodel.Team{Name: "Backend"}); !errors.Is(err, teammapper.ErrNameExists) {
+		t.Errorf("expected error '%v' but got '%v'", teammapper.ErrNameExists, err)
+	}
+
+	saved.Name = "Backend Services"
+
+	if _, err := mapper.Save(ctx, saved); err != nil {
+		t.Fatalf("expected no error on update but got '%v'", err)
+	}
+
+	loaded, err := mapper.Load(ctx, saved.ID)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if loaded.Name != "Backend Services" {
+		t.Errorf("expected team '%v' but got '%v'", saved, loaded)
+	}
+
+	all, err := mapper.LoadAll(ctx)
+	if err != nil {
+		t.Fatalf("expected no error on load all but got '%v'", err)
+	}
+
+	if len(all) != 1 {
+		t.Errorf("expected 1 team but got %d", len(all))
+	}
+}
+
+func TestMapper_Delete(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperDelete")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := teammapper.New(db)
+	ctx := context.Background()
+
+	team, err := mapper.Save(ctx, &teammodel.Team{Name: "Backend"})
+	if err != nil {
+		t.Fatalf("failed to prepare team: %v", err)
+	}
+
+	uMapper := usermapper.New(db)
+
+	user, err := uMapper.Save(ctx, &usermodel.User{Name: "Jane", TeamID: &team.ID})
+	if err != nil {
+		t.Fatalf("failed to prepare user: %v", err)
+	}
+
+	// 2. test
+	if err := mapper.Delete(ctx, team.ID); err != nil {
+		t.Fatalf("expected no error on delete but got '%v'", err)
+	}
+
+	if _, err := mapper.Load(ctx, team.ID); !errors.Is(err, teammapper.ErrNotFound) {
+		t.Errorf("expected that team was deleted but got error '%v'", err)
+	}
+
+	user, err = uMapper.Load(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("expected member to be kept but got error '%v'", err)
+	}
+
+	if user.TeamID != nil {
+		t.Errorf("expected team of member to be removed but got %s", user.TeamID)
+	}
+}
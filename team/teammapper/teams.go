@@ -0,0 +1,25 @@
+package teammapper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rebel-l/ttrack_api/team/teammodel"
+	"github.com/rebel-l/ttrack_api/team/teamstore"
+)
+
+// LoadAll returns all teams ordered by name.
+func (m *Mapper) LoadAll(ctx context.Context) (teammodel.Teams, error) {
+	s := &teamstore.Teams{}
+
+	if err := s.Load(ctx, m.db, ""); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	models := teammodel.Teams{}
+	for _, v := range *s {
+		models = append(models, StoreToModel(v))
+	}
+
+	return models, nil
+}
@@ -0,0 +1,2 @@
+// Package teammodel provides functionality and business logic to manage the teams of the service.
+package teammodel
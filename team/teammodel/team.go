@@ -0,0 +1,64 @@
+package teammodel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxLengthName defines the maximum number of characters of the team name.
+	MaxLengthName = 100
+)
+
+var (
+	// ErrDecodeJSON occurs if a string is not in JSON format.
+	ErrDecodeJSON = errors.New("failed to decode JSON")
+
+	// ErrValidationNameMandatory occurs during validation if the name wasn't set.
+	ErrValidationNameMandatory = errors.New("name should not be empty")
+
+	// ErrValidationTooLong occurs during validation if a value exceeds its maximum length.
+	ErrValidationTooLong = errors.New("value is too long")
+)
+
+// Team represents a group of users. Each user is member of at most one team, its leads can read the reports of the
+// other members.
+type Team struct {
+	ID         uuid.UUID `json:"ID"`
+	Name       string    `json:"Name"`
+	CreatedAt  time.Time `json:"CreatedAt"`
+	ModifiedAt time.Time `json:"ModifiedAt"`
+}
+
+// DecodeJSON converts JSON data to struct.
+func (t *Team) DecodeJSON(reader io.Reader) error {
+	if t == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(reader)
+	if err := decoder.Decode(t); err != nil {
+		return fmt.Errorf("%w: %v", ErrDecodeJSON, err)
+	}
+
+	return nil
+}
+
+// Validate is validating the attributes of the struct to valid values. If the validation fails it returns the reason
+// why it failed in the error message.
+func (t *Team) Validate() error {
+	if t.Name == "" {
+		return ErrValidationNameMandatory
+	}
+
+	if len([]rune(t.Name)) > MaxLengthName {
+		return fmt.Errorf("%w: name has more than %d characters", ErrValidationTooLong, MaxLengthName)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package teammodel_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rebel-l/ttrack_api/team/teammodel"
+)
+
+func TestTeam_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		team        *teammodel.Team
+		expectedErr error
+	}{
+		{
+			name:        "name missing",
+			team:        &teammodel.Team{},
+			expectedErr: teammodel.ErrValidationNameMandatory,
+		},
+		{
+			name:        "name too long",
+			team:        &teammodel.Team{Name: strings.Repeat("a", teammodel.MaxLengthName+1)},
+			expectedErr: teammodel.ErrValidationTooLong,
+		},
+		{
+			name: "valid",
+			team: &teammodel.Team{Name: "Backend"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := testCase.team.Validate(); !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+package teammodel
+
+type Teams []*Team
@@ -0,0 +1,2 @@
+// Package teamstore contains the CRUD operations for teams on the database.
+package teamstore
@@ -0,0 +1,139 @@
+package teamstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+)
+
+const (
+	qSelect = `
+		SELECT id, name, created_at, modified_at
+        FROM teams
+	`
+)
+
+var (
+	// ErrIDMissing will be thrown if an ID is expected but not set.
+	ErrIDMissing = errors.New("id is mandatory for this operation")
+
+	// ErrCreatingID will be thrown if creating an ID failed.
+	ErrCreatingID = errors.New("id creation failed")
+
+	// ErrIDIsSet will be thrown if no ID is expected but already set.
+	ErrIDIsSet = errors.New("id should be not set for this operation, use update instead")
+
+	// ErrDataMissing will be thrown if mandatory data is not set.
+	ErrDataMissing = errors.New("no data or mandatory data missing")
+)
+
+// Team represents the team in the database.
+type Team struct {
+	ID         uuid.UUID `db:"id"`
+	Name       string    `db:"name"`
+	CreatedAt  time.Time `db:"created_at"`
+	ModifiedAt time.Time `db:"modified_at"`
+}
+
+// Create creates current object in the database.
+func (t *Team) Create(ctx context.Context, db sqlx.ExtContext) error {
+	if !t.IsValid() {
+		return ErrDataMissing
+	}
+
+	if !uuidutils.IsEmpty(t.ID) {
+		return ErrIDIsSet
+	}
+
+	var err error
+
+	t.ID, err = uuid.NewRandom()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCreatingID, err)
+	}
+
+	q := db.Rebind(`
+		INSERT INTO teams (id, name)
+		VALUES (?, ?);
+	`)
+
+	_, err = db.ExecContext(ctx, q, t.ID, t.Name)
+	if err != nil {
+		return fmt.Errorf("failed to create: %w", err)
+	}
+
+	return t.Read(ctx, db)
+}
+
+// Read sets the team from database by given ID.
+func (t *Team) Read(ctx context.Context, db sqlx.ExtContext) error {
+	if t == nil || uuidutils.IsEmpty(t.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE id = ?;
+    `)
+	if err := sqlx.GetContext(ctx, db, t, q, t.ID); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// Update changes the current object on the database by ID.
+func (t *Team) Update(ctx context.Context, db sqlx.ExtContext) error {
+	if !t.IsValid() {
+		return ErrDataMissing
+	}
+
+	if uuidutils.IsEmpty(t.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+		UPDATE teams
+		SET name = ?
+		WHERE id = ?;
+	`)
+
+	_, err := db.ExecContext(ctx, q, t.Name, t.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update: %w", err)
+	}
+
+	return t.Read(ctx, db)
+}
+
+// Delete removes the current object from database by its ID.
+func (t *Team) Delete(ctx context.Context, db sqlx.ExtContext) error {
+	if t == nil || uuidutils.IsEmpty(t.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+        DELETE FROM teams
+        WHERE id = ?
+    `)
+
+	if _, err := db.ExecContext(ctx, q, t.ID); err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	return nil
+}
+
+// IsValid returns true if all mandatory fields are set.
+func (t *Team) IsValid() bool {
+	if t == nil || t.Name == "" {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,27 @@
+package teamstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Teams []*Team
+
+func (t *Teams) Load(ctx context.Context, db sqlx.ExtContext, where string, args ...any) error {
+	q := qSelect
+	if where != "" {
+		q += " WHERE " + where
+	}
+	q += " ORDER BY name "
+
+	if err := sqlx.SelectContext(ctx, db, t, db.Rebind(q), args...); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package timelogmapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogstore"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+var (
+	// ErrAlreadyClockedIn occurs if a timelog should be opened but there is already one without stop time.
+	ErrAlreadyClockedIn = errors.New("there is already a running timelog")
+
+	// ErrNotClockedIn occurs if a timelog should be closed but there is no one without stop time.
+	ErrNotClockedIn = errors.New("there is no running timelog")
+
+	// ErrMultipleRunning occurs if more than one timelog without stop time exists, so it is unclear which to close.
+	ErrMultipleRunning = errors.New("more than one running timelog")
+
+	// ErrStopBeforeStart occurs if the stop time is before the start time of the timelog.
+	ErrStopBeforeStart = errors.New("stop time is before start time")
+)
+
+// LoadRunning returns all timelogs of the user of the context which have no stop time yet.
+func (m *Mapper) LoadRunning(ctx context.Context) (timelogmodel.Timelogs, error) {
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoadFromDB, err)
+	}
+
+	return loadRunning(ctx, m.db, userID)
+}
+
+// loadRunning returns all timelogs of the given user which have no stop time yet.
+func loadRunning(ctx context.Context, db sqlx.ExtContext, userID uuid.UUID) (timelogmodel.Timelogs, error) {
+	s := &timelogstore.Timelogs{}
+
+	if err := s.Load(ctx, db, "stop IS NULL AND "+whereUser, userID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	tls := timelogmodel.Timelogs{}
+	for _, v := range *s {
+		tls = append(tls, StoreToModel(v))
+	}
+
+	return tls, nil
+}
+
+// LoadCurrent returns the single running timelog. If there is none ErrNotClockedIn is returned.
+func (m *Mapper) LoadCurrent(ctx context.Context) (*timelogmodel.Timelog, error) {
+	running, err := m.LoadRunning(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(running) {
+	case 0:
+		return nil, ErrNotClockedIn
+	case 1:
+		return running[0], nil
+	default:
+		return nil, fmt.Errorf("%w: %d timelogs have no stop time", ErrMultipleRunning, len(running))
+	}
+}
+
+// ClockIn persists the given model as new running timelog. It fails if there is already a running timelog. The check
+// and the saving are done in one transaction, so concurrent requests can't open two running timelogs.
+func (m *Mapper) ClockIn(ctx context.Context, model *timelogmodel.Timelog) (*timelogmodel.Timelog, error) {
+	if model == nil {
+		return nil, ErrNoData
+	}
+
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSaveToDB, err)
+	}
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	running, err := loadRunning(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(running) > 0 {
+		return nil, ErrAlreadyClockedIn
+	}
+
+	model.ID = uuid.Nil
+	model.Stop = nil
+
+	model, err = save(ctx, tx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	return model, nil
+}
+
+// ClockOut closes the single running timelog with the given stop time.
+func (m *Mapper) ClockOut(ctx context.Context, stop time.Time) (*timelogmodel.Timelog, error) {
+	model, err := m.LoadCurrent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if stop.Before(model.Start) {
+		return nil, ErrStopBeforeStart
+	}
+
+	model.Stop = &stop
+
+	return m.Save(ctx, model)
+}
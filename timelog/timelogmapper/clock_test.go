@@ -0,0 +1,86 @@
+package timelogmapper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func TestMapper_Clock(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperClock")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := timelogmapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+	start := time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC)
+
+	// 2. test
+	if _, err := mapper.LoadCurrent(ctx); !errors.Is(err, timelogmapper.ErrNotClockedIn) {
+		t.Errorf("expected error '%v' but got '%v'", timelogmapper.ErrNotClockedIn, err)
+	}
+
+	if _, err := mapper.ClockOut(ctx, start); !errors.Is(err, timelogmapper.ErrNotClockedIn) {
+		t.Errorf("expected error '%v' but got '%v'", timelogmapper.ErrNotClockedIn, err)
+	}
+
+	clockedIn, err := mapper.ClockIn(ctx, &timelogmodel.Timelog{
+		Start:    start,
+		Reason:   timelogmodel.ReasonWork,
+		Location: timelogmodel.LocationOffice,
+	})
+	if err != nil {
+		t.Fatalf("expected no error on clock in but got '%v'", err)
+	}
+
+	_, err = mapper.ClockIn(ctx, &timelogmodel.Timelog{
+		Start:    start.Add(time.Hour),
+		Reason:   timelogmodel.ReasonWork,
+		Location: timelogmodel.LocationOffice,
+	})
+	if !errors.Is(err, timelogmapper.ErrAlreadyClockedIn) {
+		t.Errorf("expected error '%v' but got '%v'", timelogmapper.ErrAlreadyClockedIn, err)
+	}
+
+	current, err := mapper.LoadCurrent(ctx)
+	if err != nil {
+		t.Fatalf("expected no error on loading current timelog but got '%v'", err)
+	}
+
+	assertTimelog(t, clockedIn, current)
+
+	if _, err := mapper.ClockOut(ctx, start.Add(-time.Minute)); !errors.Is(err, timelogmapper.ErrStopBeforeStart) {
+		t.Errorf("expected error '%v' but got '%v'", timelogmapper.ErrStopBeforeStart, err)
+	}
+
+	stop := start.Add(8 * time.Hour)
+	expected := *clockedIn
+	expected.Stop = &stop
+
+	clockedOut, err := mapper.ClockOut(ctx, stop)
+	if err != nil {
+		t.Fatalf("expected no error on clock out but got '%v'", err)
+	}
+
+	assertTimelog(t, &expected, clockedOut)
+
+	if _, err := mapper.LoadCurrent(ctx); !errors.Is(err, timelogmapper.ErrNotClockedIn) {
+		t.Errorf("expected error '%v' but got '%v'", timelogmapper.ErrNotClockedIn, err)
+	}
+}
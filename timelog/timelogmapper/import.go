@@ -0,0 +1,61 @@
+package timelogmapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+// Import saves the timelogs of all valid rows in one transaction. Rows which are invalid, conflict with other
+// timelogs or reference an unknown project or office are skipped and reported in the result. On a dry run the
+// transaction is rolled back, so nothing is saved.
+func (m *Mapper) Import(ctx context.Context, rows []*timelogmodel.ImportRow, dryRun bool) (*timelogmodel.ImportResult, error) {
+	res := &timelogmodel.ImportResult{DryRun: dryRun, Errors: []timelogmodel.ImportError{}} // nolint: exhaustivestruct
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	for _, row := range rows {
+		if row.Err == nil && row.Timelog == nil {
+			row.Err = ErrNoData
+		}
+
+		if row.Err == nil {
+			_, row.Err = save(ctx, tx, row.Timelog)
+			if row.Err != nil && !isRowError(row.Err) {
+				return nil, fmt.Errorf("line %d: %w", row.Line, row.Err)
+			}
+		}
+
+		if row.Err != nil {
+			res.Errors = append(res.Errors, timelogmodel.ImportError{Line: row.Line, Error: row.Err.Error()})
+
+			continue
+		}
+
+		res.Imported++
+	}
+
+	if dryRun {
+		return res, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	return res, nil
+}
+
+// isRowError returns true if the error is caused by the data of a row, so only this row fails and not the import.
+func isRowError(err error) bool {
+	return errors.Is(err, ErrOverlap) || errors.Is(err, ErrProjectNotFound) || errors.Is(err, ErrOfficeNotFound)
+}
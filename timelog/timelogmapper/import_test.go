@@ -0,0 +1,109 @@
+package timelogmapper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func TestMapper_Import(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperImport")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := timelogmapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+	row := func(line, day, startHour, stopHour int) *timelogmodel.ImportRow {
+		stop := time.Date(2024, 6, day, stopHour, 0, 0, 0, time.UTC)
+
+		return &timelogmodel.ImportRow{
+			Line: line,
+			Timelog: &timelogmodel.Timelog{
+				Start:    time.Date(2024, 6, day, startHour, 0, 0, 0, time.UTC),
+				Stop:     &stop,
+				Reason:   timelogmodel.ReasonWork,
+				Location: timelogmodel.LocationHome,
+			},
+		}
+	}
+
+	unknownOffice := row(7, 5, 8, 17)
+	officeID := uuid.New()
+	unknownOffice.Timelog.OfficeID = &officeID
+
+	rows := func() []*timelogmodel.ImportRow {
+		return []*timelogmodel.ImportRow{
+			row(2, 3, 8, 12),
+			row(3, 3, 13, 17),
+			row(4, 3, 11, 14), // overlaps with line 2 and 3
+			{Line: 5, Err: timelogmodel.ErrValidationStartMandatory},
+			row(6, 4, 8, 17),
+			unknownOffice,
+		}
+	}
+
+	assertResult := func(t *testing.T, dryRun bool, res *timelogmodel.ImportResult) {
+		t.Helper()
+
+		if res.DryRun != dryRun {
+			t.Errorf("expected DryRun %t but got %t", dryRun, res.DryRun)
+		}
+
+		if res.Imported != 3 {
+			t.Errorf("expected 3 imported rows but got %d", res.Imported)
+		}
+
+		if len(res.Errors) != 3 || res.Errors[0].Line != 4 || res.Errors[1].Line != 5 || res.Errors[2].Line != 7 {
+			t.Errorf("expected errors for lines 4, 5 and 7 but got %v", res.Errors)
+		}
+	}
+
+	// 2. test
+	res, err := mapper.Import(ctx, rows(), true)
+	if err != nil {
+		t.Fatalf("expected no error on dry run but got '%v'", err)
+	}
+
+	assertResult(t, true, res)
+
+	actual, err := mapper.LoadByDateRange(ctx, "2024-06-01", "2024-07-01")
+	if err != nil {
+		t.Fatalf("failed to load timelogs: %v", err)
+	}
+
+	if len(actual) != 0 {
+		t.Errorf("expected no timelogs after dry run but got %d", len(actual))
+	}
+
+	res, err = mapper.Import(ctx, rows(), false)
+	if err != nil {
+		t.Fatalf("expected no error on import but got '%v'", err)
+	}
+
+	assertResult(t, false, res)
+
+	actual, err = mapper.LoadByDateRange(ctx, "2024-06-01", "2024-07-01")
+	if err != nil {
+		t.Fatalf("failed to load timelogs: %v", err)
+	}
+
+	if len(actual) != 3 {
+		t.Errorf("expected 3 timelogs after import but got %d", len(actual))
+	}
+}
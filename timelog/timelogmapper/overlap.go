@@ -0,0 +1,75 @@
+package timelogmapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogstore"
+)
+
+const overlapMargin = 24 * time.Hour
+
+// ErrOverlap occurs if a timelog overlaps with already existing timelogs.
+var ErrOverlap = errors.New("timelog overlaps with existing timelogs")
+
+// OverlapError provides the IDs of the timelogs conflicting with the one to save.
+type OverlapError struct {
+	IDs []uuid.UUID
+}
+
+// Error returns the error message including the conflicting IDs.
+func (e *OverlapError) Error() string {
+	ids := make([]string, 0, len(e.IDs))
+	for _, v := range e.IDs {
+		ids = append(ids, v.String())
+	}
+
+	return fmt.Sprintf("%s: %s", ErrOverlap, strings.Join(ids, ", "))
+}
+
+// Unwrap makes the OverlapError comparable with ErrOverlap.
+func (e *OverlapError) Unwrap() error {
+	return ErrOverlap
+}
+
+// checkOverlaps loads all timelogs of the same user intersecting with the model and returns an OverlapError if any of
+// them conflicts.
+func checkOverlaps(ctx context.Context, db sqlx.ExtContext, model *timelogmodel.Timelog) error {
+	// times are compared as strings in the database, so the range is widened by a day to not miss timelogs stored
+	// with a different time zone offset. The exact check is done by the model.
+	w := "id != ? AND " + whereUser + " AND (stop IS NULL OR stop > ?)"
+	args := []any{model.ID, model.UserID, model.Start.Add(-overlapMargin)}
+
+	if model.Stop != nil {
+		w += " AND start < ?"
+		args = append(args, model.Stop.Add(overlapMargin))
+	}
+
+	s := &timelogstore.Timelogs{}
+	if err := s.Load(ctx, db, w, args...); err != nil {
+		return fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	candidates := timelogmodel.Timelogs{}
+	for _, v := range *s {
+		candidates = append(candidates, StoreToModel(v))
+	}
+
+	conflicts := candidates.ConflictsWith(model)
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	e := &OverlapError{IDs: make([]uuid.UUID, 0, len(conflicts))}
+	for _, v := range conflicts {
+		e.IDs = append(e.IDs, v.ID)
+	}
+
+	return e
+}
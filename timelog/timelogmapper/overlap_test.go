@@ -0,0 +1,106 @@
+package timelogmapper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func TestMapper_SaveOverlap(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperSaveOverlap")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := timelogmapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+	day := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+	at := func(hour, minute int) *time.Time {
+		v := day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+
+		return &v
+	}
+
+	work, err := mapper.Save(ctx, &timelogmodel.Timelog{
+		Start:    *at(8, 0),
+		Stop:     at(17, 0),
+		Reason:   timelogmodel.ReasonWork,
+		Location: timelogmodel.LocationHome,
+	})
+	if err != nil {
+		t.Fatalf("failed to prepare data: %v", err)
+	}
+
+	// 2. test
+	testCases := []struct {
+		name        string
+		actual      *timelogmodel.Timelog
+		expectedErr error
+	}{
+		{
+			name: "break inside work",
+			actual: &timelogmodel.Timelog{
+				Start:    *at(12, 0),
+				Stop:     at(12, 30),
+				Reason:   timelogmodel.ReasonBreak,
+				Location: timelogmodel.LocationHome,
+			},
+		},
+		{
+			name: "work after work",
+			actual: &timelogmodel.Timelog{
+				Start:    *at(17, 0),
+				Stop:     at(18, 0),
+				Reason:   timelogmodel.ReasonWork,
+				Location: timelogmodel.LocationHome,
+			},
+		},
+		{
+			name: "work overlapping work",
+			actual: &timelogmodel.Timelog{
+				Start:    *at(7, 0),
+				Stop:     at(8, 30),
+				Reason:   timelogmodel.ReasonWork,
+				Location: timelogmodel.LocationHome,
+			},
+			expectedErr: timelogmapper.ErrOverlap,
+		},
+		{
+			name: "update work itself",
+			actual: &timelogmodel.Timelog{
+				ID:       work.ID,
+				Start:    *at(8, 15),
+				Stop:     at(17, 0),
+				Reason:   timelogmodel.ReasonWork,
+				Location: timelogmodel.LocationHome,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := mapper.Save(ctx, testCase.actual)
+		if !errors.Is(err, testCase.expectedErr) {
+			t.Errorf("%s: expected error '%v' but got '%v'", testCase.name, testCase.expectedErr, err)
+		}
+
+		var overlapErr *timelogmapper.OverlapError
+		if errors.As(err, &overlapErr) && (len(overlapErr.IDs) != 1 || overlapErr.IDs[0] != work.ID) {
+			t.Errorf("%s: expected conflicting id %s but got %v", testCase.name, work.ID, overlapErr.IDs)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package timelogmapper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/go-utils/slice"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func TestMapper_Tags(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperTags")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := timelogmapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+	timelog := func(day int, tags ...string) *timelogmodel.Timelog {
+		stop := time.Date(2024, 6, day, 17, 0, 0, 0, time.UTC)
+
+		return &timelogmodel.Timelog{
+			Start:       time.Date(2024, 6, day, 8, 0, 0, 0, time.UTC),
+			Stop:        &stop,
+			Reason:      timelogmodel.ReasonWork,
+			Location:    timelogmodel.LocationHome,
+			Description: "worked on something",
+			Tags:        tags,
+		}
+	}
+
+	// 2. test
+	saved, err := mapper.Save(ctx, timelog(3, " Meeting", "oncall", "meeting "))
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	if !slice.StringSlice(saved.Tags).IsEqual(slice.StringSlice{"meeting", "oncall"}) {
+		t.Errorf("expected tags 'meeting, oncall' but got '%v'", saved.Tags)
+	}
+
+	if saved.Description != "worked on something" {
+		t.Errorf("expected description 'worked on something' but got %q", saved.Description)
+	}
+
+	saved.Tags = []string{"oncall"}
+
+	saved, err = mapper.Save(ctx, saved)
+	if err != nil {
+		t.Fatalf("expected no error on update but got '%v'", err)
+	}
+
+	if !slice.StringSlice(saved.Tags).IsEqual(slice.StringSlice{"oncall"}) {
+		t.Errorf("expected tags 'oncall' but got '%v'", saved.Tags)
+	}
+
+	if _, err := mapper.Save(ctx, timelog(4, "meeting")); err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	if _, err := mapper.Save(ctx, timelog(5)); err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	testCases := []struct {
+		name     string
+		tags     []string
+		expected int
+	}{
+		{name: "no filter", expected: 3},
+		{name: "single tag", tags: []string{"meeting"}, expected: 1},
+		{name: "not normalized tag", tags: []string{" MEETING"}, expected: 1},
+		{name: "multiple tags", tags: []string{"meeting", "oncall"}, expected: 2},
+		{name: "unknown tag", tags: []string{"unknown"}, expected: 0},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := mapper.LoadByDateRange(ctx, "2024-06-01", "2024-07-01", testCase.tags...)
+		if err != nil {
+			t.Fatalf("%s: expected no error on load but got '%v'", testCase.name, err)
+		}
+
+		if len(actual) != testCase.expected {
+			t.Errorf("%s: expected %d timelogs but got %d", testCase.name, testCase.expected, len(actual))
+		}
+	}
+}
@@ -9,10 +9,16 @@ import (
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/rebel-l/go-utils/uuidutils"
+	"github.com/rebel-l/ttrack_api/commute/commutestore"
+	"github.com/rebel-l/ttrack_api/project/projectstore"
 	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
 	"github.com/rebel-l/ttrack_api/timelog/timelogstore"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
 )
 
+// whereUser selects the timelogs of the user given as argument.
+const whereUser = "user_id = ?"
+
 var (
 	// ErrLoadFromDB occurs if something went wrong on loading.
 	ErrLoadFromDB = errors.New("failed to load timelog from database")
@@ -31,6 +37,12 @@ var (
 
 	// ErrConvert occurs if data type conversion failed.
 	ErrConvert = errors.New("conversion error")
+
+	// ErrProjectNotFound occurs if the timelog references a project which doesn't exist.
+	ErrProjectNotFound = errors.New("project of timelog was not found")
+
+	// ErrOfficeNotFound occurs if the timelog references an office which doesn't exist.
+	ErrOfficeNotFound = errors.New("office of timelog was not found")
 )
 
 // Mapper provides methods to load and persist timelog models.
@@ -43,8 +55,14 @@ func New(db *sqlx.DB) *Mapper {
 	return &Mapper{db: db}
 }
 
-// Load returns a timelog model loaded from database by ID.
+// Load returns a timelog model loaded from database by ID. Timelogs of other users than the one of the context are
+// not found.
 func (m *Mapper) Load(ctx context.Context, id uuid.UUID) (*timelogmodel.Timelog, error) {
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoadFromDB, err)
+	}
+
 	s := &timelogstore.Timelog{ID: id} // nolint: exhaustivestruct
 
 	if err := s.Read(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
@@ -53,68 +71,169 @@ func (m *Mapper) Load(ctx context.Context, id uuid.UUID) (*timelogmodel.Timelog,
 		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
 	}
 
+	if !ownedBy(s, userID) {
+		return nil, ErrNotFound
+	}
+
 	return StoreToModel(s), nil
 }
 
-// Save persists (create or update) the model and returns the changed data (id, createdAt or modifiedAt).
+// Save persists (create or update) the model and returns the changed data (id, createdAt or modifiedAt). It fails
+// with an OverlapError if the model conflicts with already existing timelogs. The check and the saving are done in one
+// transaction.
 func (m *Mapper) Save(ctx context.Context, model *timelogmodel.Timelog) (*timelogmodel.Timelog, error) {
 	if model == nil {
 		return nil, ErrNoData
 	}
 
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	model, err = save(ctx, tx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	return model, nil
+}
+
+// save persists the model within the given transaction after checking for overlaps and the referenced project and
+// office. The timelog belongs to the user of the context, timelogs of other users can't be updated. Tags are
+// normalized before saving.
+func save(ctx context.Context, tx *sqlx.Tx, model *timelogmodel.Timelog) (*timelogmodel.Timelog, error) {
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSaveToDB, err)
+	}
+
+	model.UserID = userID
+	model.NormalizeTags()
+
+	if !uuidutils.IsEmpty(model.ID) {
+		existing := &timelogstore.Timelog{ID: model.ID} // nolint: exhaustivestruct
+		if err := existing.Read(ctx, tx); errors.Is(err, sql.ErrNoRows) || err == nil && !ownedBy(existing, model.UserID) {
+			return nil, fmt.Errorf("%w: %w", ErrSaveToDB, ErrNotFound)
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+		}
+	}
+
+	if err := checkOverlaps(ctx, tx, model); err != nil {
+		return nil, err
+	}
+
+	if model.ProjectID != nil {
+		p := &projectstore.Project{ID: *model.ProjectID} // nolint: exhaustivestruct
+		if err := p.Read(ctx, tx); errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProjectNotFound
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+		}
+	}
+
+	if model.OfficeID != nil {
+		o := &commutestore.Office{ID: *model.OfficeID} // nolint: exhaustivestruct
+		if err := o.Read(ctx, tx); errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOfficeNotFound
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+		}
+	}
+
 	s := modelToStore(model)
 
 	if uuidutils.IsEmpty(model.ID) {
-		if err := s.Create(ctx, m.db); err != nil {
+		if err := s.Create(ctx, tx); err != nil {
 			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
 		}
 	} else {
-		if err := s.Update(ctx, m.db); err != nil {
+		if err := s.Update(ctx, tx); err != nil {
 			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
 		}
 	}
 
-	model = StoreToModel(s)
-
-	return model, nil
+	return StoreToModel(s), nil
 }
 
-// Delete removes a model from database by ID.
+// Delete removes a model from database by ID. Timelogs of other users than the one of the context are kept and
+// not found.
 func (m *Mapper) Delete(ctx context.Context, id uuid.UUID) error {
-	s := &timelogstore.Timelog{ID: id} // nolint: exhaustivestruct
-	if err := s.Delete(ctx, m.db); err != nil {
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDeleteFromDB, err)
+	}
+
+	s := &timelogstore.Timelog{ID: id, UserID: &userID} // nolint: exhaustivestruct
+	if err := s.Delete(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	} else if err != nil {
 		return fmt.Errorf("%w: %v", ErrDeleteFromDB, err)
 	}
 
 	return nil
 }
 
+// ownedBy returns true if the timelog belongs to the given user.
+func ownedBy(s *timelogstore.Timelog, userID uuid.UUID) bool {
+	return s.UserID != nil && *s.UserID == userID
+}
+
 // StoreToModel returns a model based on the given store object. It maps all properties from store to model.
 func StoreToModel(s *timelogstore.Timelog) *timelogmodel.Timelog {
 	if s == nil {
 		return &timelogmodel.Timelog{} // nolint: exhaustivestruct
 	}
 
+	var userID uuid.UUID
+	if s.UserID != nil {
+		userID = *s.UserID
+	}
+
 	return &timelogmodel.Timelog{
-		ID:         s.ID,
-		Start:      s.Start,
-		Stop:       s.Stop,
-		Reason:     s.Reason,
-		Location:   s.Location,
-		CreatedAt:  s.CreatedAt,
-		ModifiedAt: s.ModifiedAt,
+		ID:          s.ID,
+		UserID:      userID,
+		Start:       s.Start,
+		Stop:        s.Stop,
+		Reason:      s.Reason,
+		Location:    s.Location,
+		ProjectID:   s.ProjectID,
+		OfficeID:    s.OfficeID,
+		Description: s.Description,
+		Tags:        s.Tags,
+		CreatedAt:   s.CreatedAt,
+		ModifiedAt:  s.ModifiedAt,
 	}
 }
 
 // modelToStore returns a store based on the given model object. It maps all properties from model to store.
 func modelToStore(m *timelogmodel.Timelog) *timelogstore.Timelog {
+	var userID *uuid.UUID
+	if !uuidutils.IsEmpty(m.UserID) {
+		userID = &m.UserID
+	}
+
 	return &timelogstore.Timelog{
-		ID:         m.ID,
-		Start:      m.Start,
-		Stop:       m.Stop,
-		Reason:     m.Reason,
-		Location:   m.Location,
-		CreatedAt:  m.CreatedAt,
-		ModifiedAt: m.ModifiedAt,
+		ID:          m.ID,
+		UserID:      userID,
+		Start:       m.Start,
+		Stop:        m.Stop,
+		Reason:      m.Reason,
+		Location:    m.Location,
+		ProjectID:   m.ProjectID,
+		OfficeID:    m.OfficeID,
+		Description: m.Description,
+		Tags:        m.Tags,
+		CreatedAt:   m.CreatedAt,
+		ModifiedAt:  m.ModifiedAt,
 	}
 }
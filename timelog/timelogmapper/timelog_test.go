@@ -18,6 +18,7 @@ import (
 	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
 	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
 	"github.com/rebel-l/ttrack_api/timelog/timelogstore"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
 )
 
 func setup(t *testing.T, name string) *sqlx.DB {
@@ -55,13 +56,13 @@ func prepareData(db *sqlx.DB, t *timelogmodel.Timelog) (*timelogmodel.Timelog, e
 		return nil, fmt.Errorf("failed to generate id: %w", err)
 	}
 
-	ctx := context.Background()
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
 	q := db.Rebind(`
-		INSERT INTO timelogs (id, start, stop, reason, location) 
-		VALUES (?, ?, ?, ?, ?);
+		INSERT INTO timelogs (id, user_id, start, stop, reason, location) 
+		VALUES (?, ?, ?, ?, ?, ?);
 	`)
 
-	_, err = db.ExecContext(ctx, q, t.ID, t.Start, t.Stop, t.Reason, t.Location)
+	_, err = db.ExecContext(ctx, q, t.ID, usermodel.DefaultID, t.Start, t.Stop, t.Reason, t.Location)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create data: %w", err)
 	}
@@ -143,7 +144,7 @@ func TestMapper_Load(t *testing.T) {
 				testCase.expected.ID = testCase.prepare.ID
 			}
 
-			actual, err := mapper.Load(context.Background(), testCase.id)
+			actual, err := mapper.Load(usermodel.NewContext(context.Background(), usermodel.DefaultID), testCase.id)
 			if !errors.Is(err, testCase.expectedErr) {
 				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
 			}
@@ -252,7 +253,7 @@ func TestMapper_Save(t *testing.T) {
 				}
 			}
 
-			res, err := mapper.Save(context.Background(), testCase.actual)
+			res, err := mapper.Save(usermodel.NewContext(context.Background(), usermodel.DefaultID), testCase.actual)
 			if !errors.Is(err, testCase.expectedErr) {
 				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
 			}
@@ -303,8 +304,9 @@ func TestMapper_Delete(t *testing.T) {
 			},
 		},
 		{
-			name: "timelog not existing",
-			id:   testingutils.UUIDParse(t, "9468aa5c-b72f-4be5-a637-08c9cf21da6f"),
+			name:        "timelog not existing",
+			id:          testingutils.UUIDParse(t, "9468aa5c-b72f-4be5-a637-08c9cf21da6f"),
+			expectedErr: timelogmapper.ErrNotFound,
 		},
 	}
 
@@ -323,7 +325,7 @@ func TestMapper_Delete(t *testing.T) {
 				testCase.id = testCase.prepare.ID
 			}
 
-			err = mapper.Delete(context.Background(), testCase.id)
+			err = mapper.Delete(usermodel.NewContext(context.Background(), usermodel.DefaultID), testCase.id)
 			if !errors.Is(err, testCase.expectedErr) {
 				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
 
@@ -331,7 +333,7 @@ func TestMapper_Delete(t *testing.T) {
 			}
 
 			if testCase.expectedErr == nil {
-				_, err = mapper.Load(context.Background(), testCase.id)
+				_, err = mapper.Load(usermodel.NewContext(context.Background(), usermodel.DefaultID), testCase.id)
 				if !errors.Is(err, timelogmapper.ErrNotFound) {
 					t.Errorf("expected that timelog was deleted but got error '%v'", err)
 				}
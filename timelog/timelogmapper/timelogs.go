@@ -3,17 +3,57 @@ package timelogmapper
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
 	"github.com/rebel-l/ttrack_api/timelog/timelogstore"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
 )
 
-func (m *Mapper) LoadByDateRange(ctx context.Context, start, stop string) (timelogmodel.Timelogs, error) {
+// wherePeriod selects the timelogs starting on or after the first and before the second argument. Times are stored as
+// text beginning with the date, so comparing them with a date as string respects the stored time zone.
+const wherePeriod = "start >= ? AND start < ?"
+
+// periodArgs returns the arguments for wherePeriod: the first day and the day after the last day.
+func periodArgs(firstDay, lastDay time.Time) []any {
+	return []any{
+		firstDay.Format(time.DateOnly),
+		time.Date(lastDay.Year(), lastDay.Month(), lastDay.Day()+1, 0, 0, 0, 0, time.UTC).Format(time.DateOnly),
+	}
+}
+
+// whereDateRange selects the timelogs starting at or after the first and stopping before the second argument.
+const whereDateRange = "start >= ? AND (stop < ? OR stop IS NULL)"
+
+// LoadByDateRange returns the timelogs of the user of the context between start and stop. If tags are given, only
+// timelogs having at least one of them are returned.
+func (m *Mapper) LoadByDateRange(
+	ctx context.Context,
+	start, stop string,
+	tags ...string,
+) (timelogmodel.Timelogs, error) {
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoadFromDB, err)
+	}
+
 	s := &timelogstore.Timelogs{}
 
-	w := "start >= ? AND (stop < ? OR stop IS NULL)"
+	w := whereDateRange + " AND " + whereUser
+	args := []any{start, stop, userID}
+
+	if len(tags) > 0 {
+		w += ` AND id IN (
+			SELECT tt.timelog_id FROM timelog_tags tt JOIN tags tg ON tg.id = tt.tag_id
+			WHERE tg.name IN (?` + strings.Repeat(", ?", len(tags)-1) + `))`
+
+		for _, v := range tags {
+			args = append(args, timelogmodel.NormalizeTag(v))
+		}
+	}
 
-	if err := s.Load(ctx, m.db, w, start, stop); err != nil {
+	if err := s.Load(ctx, m.db, w, args...); err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
 	}
 
@@ -25,12 +65,28 @@ func (m *Mapper) LoadByDateRange(ctx context.Context, start, stop string) (timel
 	return tls, nil
 }
 
+// LoadByYear returns the timelogs of the user of the context starting in the given year.
 func (m *Mapper) LoadByYear(ctx context.Context, year int) (timelogmodel.Timelogs, error) {
-	s := &timelogstore.Timelogs{}
+	return m.LoadByPeriod(
+		ctx,
+		time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC),
+	)
+}
 
-	w := "strftime('%Y', start) = ?"
+// LoadByPeriod returns the timelogs of the user of the context starting on a day between firstDay and lastDay, both
+// included. The day of a timelog is taken in the time zone it was stored with, the time of firstDay and lastDay is
+// ignored.
+func (m *Mapper) LoadByPeriod(ctx context.Context, firstDay, lastDay time.Time) (timelogmodel.Timelogs, error) {
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoadFromDB, err)
+	}
+
+	s := &timelogstore.Timelogs{}
 
-	if err := s.Load(ctx, m.db, w, fmt.Sprintf("%d", year)); err != nil {
+	args := append(periodArgs(firstDay, lastDay), userID)
+	if err := s.Load(ctx, m.db, wherePeriod+" AND "+whereUser, args...); err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
 	}
 
@@ -41,3 +97,29 @@ func (m *Mapper) LoadByYear(ctx context.Context, year int) (timelogmodel.Timelog
 
 	return tls, nil
 }
+
+// StreamByPeriod passes the timelogs of the user of the context starting on a day between firstDay and lastDay, both
+// included, ordered by start one by one to the callback. In contrast to LoadByPeriod the timelogs are never all kept
+// in memory.
+func (m *Mapper) StreamByPeriod(
+	ctx context.Context,
+	firstDay, lastDay time.Time,
+	callback func(*timelogmodel.Timelog) error,
+) error {
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrLoadFromDB, err)
+	}
+
+	s := &timelogstore.Timelogs{}
+
+	args := append(periodArgs(firstDay, lastDay), userID)
+	err = s.Stream(ctx, m.db, func(v *timelogstore.Timelog) error {
+		return callback(StoreToModel(v))
+	}, wherePeriod+" AND "+whereUser, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package timelogmapper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func TestMapper_StreamByPeriod(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperStreamByPeriod")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := timelogmapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+
+	saved := make(map[int]*timelogmodel.Timelog)
+
+	for _, day := range []int{5, 3, 4, 10} {
+		stop := time.Date(2024, 6, day, 17, 0, 0, 0, time.UTC)
+
+		model, err := mapper.Save(ctx, &timelogmodel.Timelog{
+			Start:    time.Date(2024, 6, day, 8, 0, 0, 0, time.UTC),
+			Stop:     &stop,
+			Reason:   timelogmodel.ReasonWork,
+			Location: timelogmodel.LocationHome,
+			Tags:     []string{"day"},
+		})
+		if err != nil {
+			t.Fatalf("failed to prepare data: %v", err)
+		}
+
+		saved[day] = model
+	}
+
+	// 2. test
+	var streamed timelogmodel.Timelogs
+
+	// the last day is included completely
+	firstDay := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := time.Date(2024, 6, 5, 0, 0, 0, 0, time.UTC)
+
+	err := mapper.StreamByPeriod(ctx, firstDay, lastDay, func(timelog *timelogmodel.Timelog) error {
+		streamed = append(streamed, timelog)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	expected := []int{3, 4, 5}
+	if len(streamed) != len(expected) {
+		t.Fatalf("expected %d timelogs but got %d", len(expected), len(streamed))
+	}
+
+	for i, day := range expected {
+		assertTimelog(t, saved[day], streamed[i])
+	}
+}
+
+func TestMapper_LoadByPeriod(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperLoadByPeriod")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := timelogmapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+	cest := time.FixedZone("CEST", 2*60*60)
+
+	starts := []time.Time{
+		time.Date(2024, 3, 31, 23, 30, 0, 0, time.UTC),
+		time.Date(2024, 4, 1, 0, 30, 0, 0, cest), // still 31st of March in UTC
+		time.Date(2024, 4, 10, 22, 0, 0, 0, cest),
+		time.Date(2024, 4, 11, 0, 0, 0, 0, time.UTC),
+	}
+
+	saved := make([]*timelogmodel.Timelog, 0, len(starts))
+
+	for _, start := range starts {
+		stop := start.Add(15 * time.Minute)
+
+		model, err := mapper.Save(ctx, &timelogmodel.Timelog{
+			Start:    start,
+			Stop:     &stop,
+			Reason:   timelogmodel.ReasonWork,
+			Location: timelogmodel.LocationHome,
+		})
+		if err != nil {
+			t.Fatalf("failed to prepare data: %v", err)
+		}
+
+		saved = append(saved, model)
+	}
+
+	// 2. test
+	loaded, err := mapper.LoadByPeriod(
+		ctx,
+		time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 10, 23, 59, 59, 0, time.UTC),
+	)
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if len(loaded) != 2 {
+		t.Fatalf("expected 2 timelogs but got %d", len(loaded))
+	}
+
+	assertTimelog(t, saved[1], loaded[0])
+	assertTimelog(t, saved[2], loaded[1])
+}
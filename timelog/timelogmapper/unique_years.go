@@ -7,13 +7,20 @@ import (
 
 	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
 	"github.com/rebel-l/ttrack_api/timelog/timelogstore"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
 )
 
-// GetUniqueYears returns a list of years extracted from the time logs. These years are unique.
+// GetUniqueYears returns a list of years extracted from the time logs of the user of the context. These years are
+// unique.
 func (m *Mapper) GetUniqueYears(ctx context.Context) (timelogmodel.UniqueYears, error) {
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoadFromDB, err)
+	}
+
 	var s timelogstore.UniqueYears
 
-	if err := s.Get(ctx, m.db); err != nil {
+	if err := s.Get(ctx, m.db, userID); err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
 	}
 
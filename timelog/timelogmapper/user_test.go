@@ -0,0 +1,98 @@
+package timelogmapper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/user/usermapper"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func TestMapper_User(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperUser")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	user, err := usermapper.New(db).Save(context.Background(), &usermodel.User{Name: "Jane"})
+	if err != nil {
+		t.Fatalf("failed to prepare user: %v", err)
+	}
+
+	mapper := timelogmapper.New(db)
+	ctxDefault := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+	ctxUser := usermodel.NewContext(context.Background(), user.ID)
+	stop := time.Date(2024, 6, 3, 17, 0, 0, 0, time.UTC)
+	timelog := func() *timelogmodel.Timelog {
+		return &timelogmodel.Timelog{
+			Start:    time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC),
+			Stop:     &stop,
+			Reason:   timelogmodel.ReasonWork,
+			Location: timelogmodel.LocationHome,
+		}
+	}
+
+	own, err := mapper.Save(ctxDefault, timelog())
+	if err != nil {
+		t.Fatalf("failed to prepare timelog of default user: %v", err)
+	}
+
+	// 2. test
+	// the same time doesn't overlap with timelogs of other users
+	other, err := mapper.Save(ctxUser, timelog())
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	if own.UserID != usermodel.DefaultID || other.UserID != user.ID {
+		t.Errorf("expected owners %s and %s but got %s and %s", usermodel.DefaultID, user.ID, own.UserID, other.UserID)
+	}
+
+	if _, err := mapper.Load(ctxDefault, other.ID); !errors.Is(err, timelogmapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", timelogmapper.ErrNotFound, err)
+	}
+
+	if _, err := mapper.Save(ctxDefault, other); !errors.Is(err, timelogmapper.ErrSaveToDB) {
+		t.Errorf("expected error '%v' but got '%v'", timelogmapper.ErrSaveToDB, err)
+	}
+
+	timelogs, err := mapper.LoadByYear(ctxUser, 2024)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if len(timelogs) != 1 || timelogs[0].ID != other.ID {
+		t.Errorf("expected only timelog %s but got %v", other.ID, timelogs)
+	}
+
+	years, err := mapper.GetUniqueYears(usermodel.NewContext(context.Background(), usermodel.DefaultID))
+	if err != nil {
+		t.Fatalf("expected no error on unique years but got '%v'", err)
+	}
+
+	if len(years) != 1 || years[0] != 2024 {
+		t.Errorf("expected unique years [2024] but got %v", years)
+	}
+
+	if err := mapper.Delete(ctxDefault, other.ID); !errors.Is(err, timelogmapper.ErrNotFound) {
+		t.Fatalf("expected error '%v' on delete but got '%v'", timelogmapper.ErrNotFound, err)
+	}
+
+	if _, err := mapper.Load(ctxUser, other.ID); err != nil {
+		t.Errorf("expected timelog of other user to be kept but got error '%v'", err)
+	}
+}
@@ -0,0 +1,281 @@
+package timelogmodel
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// CSVColumnStart is the name of the CSV column containing the start time.
+	CSVColumnStart = "start"
+
+	// CSVColumnStop is the name of the CSV column containing the stop time.
+	CSVColumnStop = "stop"
+
+	// CSVColumnReason is the name of the CSV column containing the reason.
+	CSVColumnReason = "reason"
+
+	// CSVColumnLocation is the name of the CSV column containing the location.
+	CSVColumnLocation = "location"
+
+	// CSVColumnNotes is the name of the CSV column containing the description.
+	CSVColumnNotes = "notes"
+
+	// CSVColumnTags is the name of the CSV column containing the tags separated by CSVTagSeparator.
+	CSVColumnTags = "tags"
+
+	// CSVColumnProject is the name of the CSV column containing the ID of the project.
+	CSVColumnProject = "project_id"
+
+	// CSVColumnOffice is the name of the CSV column containing the ID of the office.
+	CSVColumnOffice = "office_id"
+
+	// CSVColumnID is the name of the CSV column containing the ID. It is ignored on import.
+	CSVColumnID = "id"
+
+	// CSVColumnDurationMinutes is the name of the computed CSV column containing the duration in minutes. It is
+	// ignored on import.
+	CSVColumnDurationMinutes = "duration_minutes"
+
+	// CSVColumnDurationHours is the name of the computed CSV column containing the duration in hours. It is ignored
+	// on import.
+	CSVColumnDurationHours = "duration_hours"
+
+	// CSVTagSeparator separates the tags inside the tags column.
+	CSVTagSeparator = "|"
+)
+
+var (
+	// ErrCSVMissingColumn occurs if a mandatory column is missing in the header of the CSV.
+	ErrCSVMissingColumn = errors.New("CSV header misses mandatory column")
+
+	// ErrCSVRead occurs if the CSV data is malformed.
+	ErrCSVRead = errors.New("failed to read CSV")
+
+	// ErrCSVInvalidProject occurs if the project in the CSV is no UUID.
+	ErrCSVInvalidProject = errors.New("project must be a UUID")
+
+	// ErrCSVInvalidOffice occurs if the office in the CSV is no UUID.
+	ErrCSVInvalidOffice = errors.New("office must be a UUID")
+
+	// ErrCSVInvalidTime occurs if a time in the CSV has no known format.
+	ErrCSVInvalidTime = errors.New("time must be in format RFC3339, '2006-01-02 15:04:05' or '2006-01-02 15:04'")
+
+	csvTimeFormats = []string{
+		time.RFC3339Nano,
+		time.DateTime,
+		"2006-01-02 15:04",
+	}
+)
+
+// ImportRow represents a line of an import. It contains either the timelog read from the line or the error why the
+// line is invalid.
+type ImportRow struct {
+	Line    int
+	Timelog *Timelog
+	Err     error
+}
+
+// ImportError describes why a line of an import was not imported.
+type ImportError struct {
+	Line  int    `json:"Line"`
+	Error string `json:"Error"`
+}
+
+// ImportResult summarises an import.
+type ImportResult struct {
+	DryRun   bool          `json:"DryRun"`
+	Imported int           `json:"Imported"`
+	Errors   []ImportError `json:"Errors"`
+}
+
+// ReadCSV reads timelogs from CSV data. The first line must be a header naming the columns, unknown columns are
+// ignored. Every line is validated, lines failing are returned with their error. An error is only returned if the CSV
+// itself can't be read.
+func ReadCSV(reader io.Reader) ([]*ImportRow, error) {
+	r := csv.NewReader(reader)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCSVRead, err)
+	}
+
+	columns := make(map[string]int)
+	for i, v := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(v, "\ufeff")))] = i
+	}
+
+	for _, v := range []string{CSVColumnStart, CSVColumnReason, CSVColumnLocation} {
+		if _, ok := columns[v]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrCSVMissingColumn, v)
+		}
+	}
+
+	var rows []*ImportRow
+
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCSVRead, err)
+		}
+
+		line, _ := r.FieldPos(0)
+		row := &ImportRow{Line: line} // nolint: exhaustivestruct
+		row.Timelog, row.Err = recordToTimelog(record, columns)
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func recordToTimelog(record []string, columns map[string]int) (*Timelog, error) {
+	value := func(column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[i])
+	}
+
+	t := &Timelog{ // nolint: exhaustivestruct
+		Reason:      value(CSVColumnReason),
+		Location:    value(CSVColumnLocation),
+		Description: value(CSVColumnNotes),
+	}
+
+	var err error
+
+	if v := value(CSVColumnStart); v != "" {
+		if t.Start, err = parseCSVTime(v); err != nil {
+			return nil, fmt.Errorf("%s: %w", CSVColumnStart, err)
+		}
+	}
+
+	if v := value(CSVColumnStop); v != "" {
+		stop, err := parseCSVTime(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", CSVColumnStop, err)
+		}
+
+		t.Stop = &stop
+	}
+
+	if v := value(CSVColumnProject); v != "" {
+		projectID, err := uuid.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w: %q", CSVColumnProject, ErrCSVInvalidProject, v)
+		}
+
+		t.ProjectID = &projectID
+	}
+
+	if v := value(CSVColumnOffice); v != "" {
+		officeID, err := uuid.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w: %q", CSVColumnOffice, ErrCSVInvalidOffice, v)
+		}
+
+		t.OfficeID = &officeID
+	}
+
+	if v := value(CSVColumnTags); v != "" {
+		for _, tag := range strings.Split(v, CSVTagSeparator) {
+			t.Tags = append(t.Tags, strings.TrimSpace(tag))
+		}
+	}
+
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func parseCSVTime(value string) (time.Time, error) {
+	for _, format := range csvTimeFormats {
+		if t, err := time.ParseInLocation(format, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %q", ErrCSVInvalidTime, value)
+}
+
+// CSVWriter writes timelogs as CSV in the format ReadCSV is able to read.
+type CSVWriter struct {
+	writer *csv.Writer
+}
+
+// NewCSVWriter returns a CSVWriter writing to the given writer.
+func NewCSVWriter(writer io.Writer) *CSVWriter {
+	return &CSVWriter{writer: csv.NewWriter(writer)}
+}
+
+// WriteHeader writes the line naming the columns.
+func (c *CSVWriter) WriteHeader() error {
+	return c.writer.Write([]string{ // nolint: wrapcheck
+		CSVColumnID,
+		CSVColumnStart,
+		CSVColumnStop,
+		CSVColumnReason,
+		CSVColumnLocation,
+		CSVColumnNotes,
+		CSVColumnTags,
+		CSVColumnProject,
+		CSVColumnOffice,
+		CSVColumnDurationMinutes,
+		CSVColumnDurationHours,
+	})
+}
+
+// Write writes the timelog as one line. The duration columns stay empty if the timelog has no stop time.
+func (c *CSVWriter) Write(t *Timelog) error {
+	var stop, projectID, officeID, minutes, hours string
+
+	if t.Stop != nil {
+		stop = t.Stop.Format(time.RFC3339)
+		minutes = strconv.FormatFloat(t.Duration().Minutes(), 'f', 0, 64)
+		hours = strconv.FormatFloat(t.Duration().Hours(), 'f', 2, 64)
+	}
+
+	if t.ProjectID != nil {
+		projectID = t.ProjectID.String()
+	}
+
+	if t.OfficeID != nil {
+		officeID = t.OfficeID.String()
+	}
+
+	return c.writer.Write([]string{ // nolint: wrapcheck
+		t.ID.String(),
+		t.Start.Format(time.RFC3339),
+		stop,
+		t.Reason,
+		t.Location,
+		t.Description,
+		strings.Join(t.Tags, CSVTagSeparator),
+		projectID,
+		officeID,
+		minutes,
+		hours,
+	})
+}
+
+// Flush writes any buffered data to the underlying writer and returns an error if writing failed before.
+func (c *CSVWriter) Flush() error {
+	c.writer.Flush()
+
+	return c.writer.Error() // nolint: wrapcheck
+}
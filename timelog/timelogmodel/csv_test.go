@@ -0,0 +1,219 @@
+package timelogmodel_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+func TestReadCSV(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		csv            string
+		expectedErr    error
+		expectedRows   int
+		expectedFailed []int
+	}{
+		{
+			name:        "empty",
+			csv:         "",
+			expectedErr: timelogmodel.ErrCSVRead,
+		},
+		{
+			name:        "header misses location",
+			csv:         "start,stop,reason\n2024-06-03 08:00,2024-06-03 17:00,work\n",
+			expectedErr: timelogmodel.ErrCSVMissingColumn,
+		},
+		{
+			name:        "malformed",
+			csv:         "start,stop,reason,location\n\"2024-06-03 08:00,2024-06-03 17:00,work,home\n",
+			expectedErr: timelogmodel.ErrCSVRead,
+		},
+		{
+			name: "valid and invalid rows",
+			csv: "Start,Stop,Reason,Location,Notes,Tags,Duration\n" +
+				"2024-06-03T08:00:00+02:00,2024-06-03T12:00:00+02:00,work,home,planning,meeting|oncall,4h0m0s\n" +
+				"2024-06-03 12:00,2024-06-03 12:30,break,home,,,\n" +
+				"yesterday,,work,home,,,\n" +
+				"2024-06-03 13:00,,party,home,,,\n" +
+				"2024-06-04 08:00,,work,office\n",
+			expectedRows:   5,
+			expectedFailed: []int{4, 5},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			rows, err := timelogmodel.ReadCSV(strings.NewReader(testCase.csv))
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+
+			if len(rows) != testCase.expectedRows {
+				t.Fatalf("expected %d rows but got %d", testCase.expectedRows, len(rows))
+			}
+
+			var failed []int
+
+			for _, row := range rows {
+				if row.Err != nil {
+					failed = append(failed, row.Line)
+				}
+			}
+
+			if len(failed) != len(testCase.expectedFailed) {
+				t.Fatalf("expected failed lines %v but got %v", testCase.expectedFailed, failed)
+			}
+
+			for i, v := range testCase.expectedFailed {
+				if failed[i] != v {
+					t.Errorf("expected failed lines %v but got %v", testCase.expectedFailed, failed)
+				}
+			}
+		})
+	}
+}
+
+func TestReadCSV_Values(t *testing.T) {
+	t.Parallel()
+
+	csv := "start,stop,reason,location,notes,tags\n" +
+		"2024-06-03T08:00:00Z,2024-06-03T12:00:00Z,work,office,planning, meeting | oncall \n"
+
+	rows, err := timelogmodel.ReadCSV(strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if len(rows) != 1 || rows[0].Err != nil {
+		t.Fatalf("expected one valid row but got %v", rows)
+	}
+
+	actual := rows[0].Timelog
+	stop := time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC)
+	expected := &timelogmodel.Timelog{
+		Start:       time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC),
+		Stop:        &stop,
+		Reason:      timelogmodel.ReasonWork,
+		Location:    timelogmodel.LocationOffice,
+		Description: "planning",
+	}
+
+	assertTimelog(t, expected, actual)
+
+	if actual.Description != expected.Description {
+		t.Errorf("expected Description %q but got %q", expected.Description, actual.Description)
+	}
+
+	if len(actual.Tags) != 2 || actual.Tags[0] != "meeting" || actual.Tags[1] != "oncall" {
+		t.Errorf("expected Tags [meeting oncall] but got %v", actual.Tags)
+	}
+
+	if rows[0].Line != 2 {
+		t.Errorf("expected Line 2 but got %d", rows[0].Line)
+	}
+}
+
+func TestCSVWriter_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC)
+	stop := start.Add(4*time.Hour + 30*time.Minute)
+	projectID := uuid.MustParse("5f0d3c1e-7b1a-4c8e-9d2f-6a5b4c3d2e1f")
+	officeID := uuid.MustParse("0b8f3a52-4d6e-4f1a-9c7b-2e5d8a1f6c3b")
+
+	timelogs := timelogmodel.Timelogs{
+		{
+			Start:       start,
+			Stop:        &stop,
+			Reason:      timelogmodel.ReasonWork,
+			Location:    timelogmodel.LocationOffice,
+			Description: "planning, \"sprint\" 42",
+			Tags:        []string{"meeting", "oncall"},
+			ProjectID:   &projectID,
+			OfficeID:    &officeID,
+		},
+		{
+			Start:    start.Add(5 * time.Hour),
+			Reason:   timelogmodel.ReasonWork,
+			Location: timelogmodel.LocationHome,
+		},
+	}
+
+	buf := &strings.Builder{}
+	w := timelogmodel.NewCSVWriter(buf)
+
+	if err := w.WriteHeader(); err != nil {
+		t.Fatalf("expected no error on writing header but got '%v'", err)
+	}
+
+	for _, v := range timelogs {
+		if err := w.Write(v); err != nil {
+			t.Fatalf("expected no error on writing timelog but got '%v'", err)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		t.Fatalf("expected no error on flush but got '%v'", err)
+	}
+
+	if !strings.Contains(buf.String(), ",270,4.50\n") {
+		t.Errorf("expected duration columns '270' and '4.50' in %q", buf.String())
+	}
+
+	rows, err := timelogmodel.ReadCSV(strings.NewReader(buf.String()))
+	if err != nil {
+		t.Fatalf("expected no error on reading exported CSV but got '%v'", err)
+	}
+
+	if len(rows) != len(timelogs) {
+		t.Fatalf("expected %d rows but got %d", len(timelogs), len(rows))
+	}
+
+	for i, row := range rows {
+		if row.Err != nil {
+			t.Fatalf("expected no error on row %d but got '%v'", row.Line, row.Err)
+		}
+
+		expected := timelogs[i]
+		actual := row.Timelog
+
+		if !expected.Start.Equal(actual.Start) {
+			t.Errorf("expected start %s but got %s", expected.Start, actual.Start)
+		}
+
+		if expected.Duration() != actual.Duration() {
+			t.Errorf("expected duration %s but got %s", expected.Duration(), actual.Duration())
+		}
+
+		if expected.Reason != actual.Reason || expected.Location != actual.Location {
+			t.Errorf("expected %s/%s but got %s/%s", expected.Reason, expected.Location, actual.Reason, actual.Location)
+		}
+
+		if expected.Description != actual.Description {
+			t.Errorf("expected description %q but got %q", expected.Description, actual.Description)
+		}
+
+		if (expected.ProjectID == nil) != (actual.ProjectID == nil) ||
+			expected.ProjectID != nil && *expected.ProjectID != *actual.ProjectID {
+			t.Errorf("expected project %v but got %v", expected.ProjectID, actual.ProjectID)
+		}
+
+		if (expected.OfficeID == nil) != (actual.OfficeID == nil) ||
+			expected.OfficeID != nil && *expected.OfficeID != *actual.OfficeID {
+			t.Errorf("expected office %v but got %v", expected.OfficeID, actual.OfficeID)
+		}
+
+		if strings.Join(expected.Tags, ",") != strings.Join(actual.Tags, ",") {
+			t.Errorf("expected tags %v but got %v", expected.Tags, actual.Tags)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package timelogmodel
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+)
+
+// ExportRecord is a timelog extended by its computed duration.
+type ExportRecord struct {
+	*Timelog
+	DurationMinutes *float64 `json:"DurationMinutes"`
+	DurationHours   *float64 `json:"DurationHours"`
+}
+
+// NewExportRecord returns the export record of the timelog. The durations are nil if the timelog has no stop time.
+func NewExportRecord(t *Timelog) *ExportRecord {
+	r := &ExportRecord{Timelog: t} // nolint: exhaustivestruct
+	if t.Stop != nil {
+		minutes := math.Round(t.Duration().Minutes())
+		hours := math.Round(t.Duration().Hours()*100) / 100 // nolint: gomnd
+		r.DurationMinutes = &minutes
+		r.DurationHours = &hours
+	}
+
+	return r
+}
+
+// NDJSONWriter writes timelogs as newline delimited JSON, one export record per line.
+type NDJSONWriter struct {
+	encoder *json.Encoder
+}
+
+// NewNDJSONWriter returns a NDJSONWriter writing to the given writer.
+func NewNDJSONWriter(writer io.Writer) *NDJSONWriter {
+	return &NDJSONWriter{encoder: json.NewEncoder(writer)}
+}
+
+// Write writes the timelog as one line.
+func (n *NDJSONWriter) Write(t *Timelog) error {
+	return n.encoder.Encode(NewExportRecord(t)) // nolint: wrapcheck
+}
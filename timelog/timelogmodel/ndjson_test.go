@@ -0,0 +1,78 @@
+package timelogmodel_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+)
+
+func TestNDJSONWriter_Write(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC)
+	stop := start.Add(90 * time.Minute)
+	officeID := uuid.MustParse("0b8f3a52-4d6e-4f1a-9c7b-2e5d8a1f6c3b")
+
+	buf := &strings.Builder{}
+	w := timelogmodel.NewNDJSONWriter(buf)
+
+	for _, v := range []*timelogmodel.Timelog{
+		{
+			Start:    start,
+			Stop:     &stop,
+			Reason:   timelogmodel.ReasonWork,
+			Location: timelogmodel.LocationHome,
+			OfficeID: &officeID,
+		},
+		{Start: stop, Reason: timelogmodel.ReasonWork, Location: timelogmodel.LocationHome},
+	} {
+		if err := w.Write(v); err != nil {
+			t.Fatalf("expected no error but got '%v'", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines but got %d: %q", len(lines), buf.String())
+	}
+
+	var record struct {
+		Start           time.Time
+		OfficeID        *uuid.UUID
+		DurationMinutes *float64
+		DurationHours   *float64
+	}
+
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatalf("expected no error on decoding but got '%v'", err)
+	}
+
+	if !record.Start.Equal(start) {
+		t.Errorf("expected start %s but got %s", start, record.Start)
+	}
+
+	if record.OfficeID == nil || *record.OfficeID != officeID {
+		t.Errorf("expected office %s but got %v", officeID, record.OfficeID)
+	}
+
+	if record.DurationMinutes == nil || *record.DurationMinutes != 90 {
+		t.Errorf("expected 90 minutes but got %v", record.DurationMinutes)
+	}
+
+	if record.DurationHours == nil || *record.DurationHours != 1.5 {
+		t.Errorf("expected 1.5 hours but got %v", record.DurationHours)
+	}
+
+	record.DurationMinutes = nil
+	if err := json.Unmarshal([]byte(lines[1]), &record); err != nil {
+		t.Fatalf("expected no error on decoding but got '%v'", err)
+	}
+
+	if record.DurationMinutes != nil {
+		t.Errorf("expected no duration for running timelog but got %v", *record.DurationMinutes)
+	}
+}
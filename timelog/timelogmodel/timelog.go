@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -32,6 +33,12 @@ const (
 
 	// ReasonVacation defines the value for the timelog reason that this entry is vacation.
 	ReasonVacation = "vacation"
+
+	// MaxLengthDescription defines the maximum number of characters of the description.
+	MaxLengthDescription = 1000
+
+	// MaxLengthTag defines the maximum number of characters of a tag.
+	MaxLengthTag = 50
 )
 
 var (
@@ -47,6 +54,12 @@ var (
 	// ErrValidationInvalidReason occurs during validation if the reason is not one of the known ones.
 	ErrValidationInvalidReason = fmt.Errorf("reason must be one of the following values")
 
+	// ErrValidationDescriptionTooLong occurs during validation if the description exceeds its maximum length.
+	ErrValidationDescriptionTooLong = fmt.Errorf("description must not have more than %d characters", MaxLengthDescription)
+
+	// ErrValidationInvalidTag occurs during validation if a tag is empty or exceeds its maximum length.
+	ErrValidationInvalidTag = fmt.Errorf("tags must not be empty or have more than %d characters", MaxLengthTag)
+
 	locations = slice.StringSlice{
 		LocationAbsence,
 		LocationHome,
@@ -63,13 +76,18 @@ var (
 
 // Timelog represents a model of repository including business logic.
 type Timelog struct {
-	ID         uuid.UUID  `json:"ID"`
-	Start      time.Time  `json:"Start"`
-	Stop       *time.Time `json:"Stop,omitempty"`
-	Reason     string     `json:"Reason"`
-	Location   string     `json:"Location"`
-	CreatedAt  time.Time  `json:"CreatedAt"`
-	ModifiedAt time.Time  `json:"ModifiedAt"`
+	ID          uuid.UUID  `json:"ID"`
+	UserID      uuid.UUID  `json:"UserID"`
+	Start       time.Time  `json:"Start"`
+	Stop        *time.Time `json:"Stop,omitempty"`
+	Reason      string     `json:"Reason"`
+	Location    string     `json:"Location"`
+	ProjectID   *uuid.UUID `json:"ProjectID,omitempty"`
+	OfficeID    *uuid.UUID `json:"OfficeID,omitempty"`
+	Description string     `json:"Description"`
+	Tags        []string   `json:"Tags"`
+	CreatedAt   time.Time  `json:"CreatedAt"`
+	ModifiedAt  time.Time  `json:"ModifiedAt"`
 }
 
 // DecodeJSON converts JSON data to struct.
@@ -80,7 +98,7 @@ func (t *Timelog) DecodeJSON(reader io.Reader) error {
 
 	decoder := json.NewDecoder(reader)
 	if err := decoder.Decode(t); err != nil {
-		return fmt.Errorf("%w: %v", ErrDecodeJSON, err)
+		return fmt.Errorf("%w: %w", ErrDecodeJSON, err)
 	}
 
 	return nil
@@ -101,5 +119,91 @@ func (t *Timelog) Validate() error {
 		return fmt.Errorf("%w: %s", ErrValidationInvalidReason, reasons.String())
 	}
 
+	if len([]rune(t.Description)) > MaxLengthDescription {
+		return ErrValidationDescriptionTooLong
+	}
+
+	for _, tag := range t.Tags {
+		if normalized := NormalizeTag(tag); normalized == "" || len([]rune(normalized)) > MaxLengthTag {
+			return fmt.Errorf("%w: %q", ErrValidationInvalidTag, tag)
+		}
+	}
+
 	return nil
 }
+
+// NormalizeTags trims and lower-cases the tags and removes duplicates. The order of the first occurrences is kept.
+func (t *Timelog) NormalizeTags() {
+	if t == nil || t.Tags == nil {
+		return
+	}
+
+	seen := make(map[string]bool)
+	tags := make([]string, 0, len(t.Tags))
+
+	for _, tag := range t.Tags {
+		tag = NormalizeTag(tag)
+		if seen[tag] {
+			continue
+		}
+
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+
+	t.Tags = tags
+}
+
+// NormalizeTag returns the tag without surrounding spaces in lower case, so tags differing only in this are the same.
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// Duration returns the time between start and stop. A running timelog has no duration yet.
+func (t *Timelog) Duration() time.Duration {
+	if t == nil || t.Stop == nil {
+		return 0
+	}
+
+	return t.Stop.Sub(t.Start)
+}
+
+// Overlaps returns true if the time ranges of both timelogs intersect. A timelog without stop time is treated as
+// running endlessly.
+func (t *Timelog) Overlaps(other *Timelog) bool {
+	if t == nil || other == nil {
+		return false
+	}
+
+	return (other.Stop == nil || t.Start.Before(*other.Stop)) && (t.Stop == nil || other.Start.Before(*t.Stop))
+}
+
+// Contains returns true if the other timelog lies completely inside the time range of this timelog.
+func (t *Timelog) Contains(other *Timelog) bool {
+	if t == nil || other == nil || other.Start.Before(t.Start) {
+		return false
+	}
+
+	if t.Stop == nil {
+		return true
+	}
+
+	return other.Stop != nil && !other.Stop.After(*t.Stop)
+}
+
+// ConflictsWith returns true if both timelogs overlap. Breaks lying inside a work timelog are not a conflict.
+func (t *Timelog) ConflictsWith(other *Timelog) bool {
+	if !t.Overlaps(other) {
+		return false
+	}
+
+	if t.Reason == ReasonBreak && other.Reason == ReasonWork && other.Contains(t) {
+		return false
+	}
+
+	if other.Reason == ReasonBreak && t.Reason == ReasonWork && t.Contains(other) {
+		return false
+	}
+
+	return true
+}
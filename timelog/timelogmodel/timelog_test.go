@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -36,6 +38,13 @@ func TestTimelog_DecodeJSON(t *testing.T) {
 			expected:    &timelogmodel.Timelog{},
 			expectedErr: timelogmodel.ErrDecodeJSON,
 		},
+		{
+			name:        "no body",
+			actual:      &timelogmodel.Timelog{},
+			json:        bytes.NewReader(nil),
+			expected:    &timelogmodel.Timelog{},
+			expectedErr: io.EOF,
+		},
 		{
 			name:   "success",
 			actual: &timelogmodel.Timelog{},
@@ -85,6 +94,180 @@ func TestTimelog_DecodeJSON(t *testing.T) {
 	}
 }
 
+func TestTimelog_Validate(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		actual      *timelogmodel.Timelog
+		expectedErr error
+	}{
+		{
+			name:        "start missing",
+			actual:      &timelogmodel.Timelog{Reason: timelogmodel.ReasonWork, Location: timelogmodel.LocationHome},
+			expectedErr: timelogmodel.ErrValidationStartMandatory,
+		},
+		{
+			name:        "invalid location",
+			actual:      &timelogmodel.Timelog{Start: start, Reason: timelogmodel.ReasonWork, Location: "moon"},
+			expectedErr: timelogmodel.ErrValidationInvalidLocation,
+		},
+		{
+			name:        "invalid reason",
+			actual:      &timelogmodel.Timelog{Start: start, Reason: "party", Location: timelogmodel.LocationHome},
+			expectedErr: timelogmodel.ErrValidationInvalidReason,
+		},
+		{
+			name: "description too long",
+			actual: &timelogmodel.Timelog{
+				Start:       start,
+				Reason:      timelogmodel.ReasonWork,
+				Location:    timelogmodel.LocationHome,
+				Description: strings.Repeat("d", timelogmodel.MaxLengthDescription+1),
+			},
+			expectedErr: timelogmodel.ErrValidationDescriptionTooLong,
+		},
+		{
+			name: "empty tag",
+			actual: &timelogmodel.Timelog{
+				Start:    start,
+				Reason:   timelogmodel.ReasonWork,
+				Location: timelogmodel.LocationHome,
+				Tags:     []string{"meeting", " "},
+			},
+			expectedErr: timelogmodel.ErrValidationInvalidTag,
+		},
+		{
+			name: "tag too long",
+			actual: &timelogmodel.Timelog{
+				Start:    start,
+				Reason:   timelogmodel.ReasonWork,
+				Location: timelogmodel.LocationHome,
+				Tags:     []string{strings.Repeat("t", timelogmodel.MaxLengthTag+1)},
+			},
+			expectedErr: timelogmodel.ErrValidationInvalidTag,
+		},
+		{
+			name: "valid",
+			actual: &timelogmodel.Timelog{
+				Start:       start,
+				Reason:      timelogmodel.ReasonWork,
+				Location:    timelogmodel.LocationHome,
+				Description: "weekly planning",
+				Tags:        []string{"meeting", "oncall"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.actual.Validate()
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestTimelog_NormalizeTags(t *testing.T) {
+	t.Parallel()
+
+	actual := &timelogmodel.Timelog{Tags: []string{" Meeting", "oncall", "MEETING ", "OnCall", "review"}}
+	actual.NormalizeTags()
+
+	if expected := []string{"meeting", "oncall", "review"}; !reflect.DeepEqual(expected, actual.Tags) {
+		t.Errorf("expected tags %v but got %v", expected, actual.Tags)
+	}
+}
+
+func TestTimelog_ConflictsWith(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+	at := func(hour, minute int) *time.Time {
+		v := day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+
+		return &v
+	}
+
+	work := &timelogmodel.Timelog{Start: *at(8, 0), Stop: at(17, 0), Reason: timelogmodel.ReasonWork}
+
+	testCases := []struct {
+		name     string
+		actual   *timelogmodel.Timelog
+		other    *timelogmodel.Timelog
+		expected bool
+	}{
+		{
+			name:     "other is nil",
+			actual:   work,
+			expected: false,
+		},
+		{
+			name:     "work before work",
+			actual:   work,
+			other:    &timelogmodel.Timelog{Start: *at(6, 0), Stop: at(8, 0), Reason: timelogmodel.ReasonWork},
+			expected: false,
+		},
+		{
+			name:     "work after work",
+			actual:   work,
+			other:    &timelogmodel.Timelog{Start: *at(17, 0), Stop: at(18, 0), Reason: timelogmodel.ReasonWork},
+			expected: false,
+		},
+		{
+			name:     "work overlapping work",
+			actual:   work,
+			other:    &timelogmodel.Timelog{Start: *at(16, 0), Stop: at(18, 0), Reason: timelogmodel.ReasonWork},
+			expected: true,
+		},
+		{
+			name:     "running work after start of work",
+			actual:   work,
+			other:    &timelogmodel.Timelog{Start: *at(16, 59), Reason: timelogmodel.ReasonWork},
+			expected: true,
+		},
+		{
+			name:     "break inside work",
+			actual:   work,
+			other:    &timelogmodel.Timelog{Start: *at(12, 0), Stop: at(12, 30), Reason: timelogmodel.ReasonBreak},
+			expected: false,
+		},
+		{
+			name:     "work containing break",
+			actual:   &timelogmodel.Timelog{Start: *at(12, 0), Stop: at(12, 30), Reason: timelogmodel.ReasonBreak},
+			other:    work,
+			expected: false,
+		},
+		{
+			name:     "break partially outside work",
+			actual:   work,
+			other:    &timelogmodel.Timelog{Start: *at(16, 30), Stop: at(17, 30), Reason: timelogmodel.ReasonBreak},
+			expected: true,
+		},
+		{
+			name:     "vacation overlapping work",
+			actual:   work,
+			other:    &timelogmodel.Timelog{Start: *at(12, 0), Stop: at(13, 0), Reason: timelogmodel.ReasonVacation},
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := testCase.actual.ConflictsWith(testCase.other); actual != testCase.expected {
+				t.Errorf("expected conflict to be %t but got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
 func assertTimelog(t *testing.T, expected, actual *timelogmodel.Timelog) { // nolint: gocognit
 	t.Helper()
 
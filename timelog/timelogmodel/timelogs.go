@@ -1,3 +1,16 @@
 package timelogmodel
 
 type Timelogs []*Timelog
+
+// ConflictsWith returns all timelogs of the list conflicting with the given one, see Timelog.ConflictsWith.
+func (t Timelogs) ConflictsWith(timelog *Timelog) Timelogs {
+	conflicts := Timelogs{}
+
+	for _, v := range t {
+		if v.ID != timelog.ID && v.ConflictsWith(timelog) {
+			conflicts = append(conflicts, v)
+		}
+	}
+
+	return conflicts
+}
@@ -0,0 +1,113 @@
+package timelogstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// TimelogTag represents the assignment of a tag to a timelog in the database.
+type TimelogTag struct {
+	TimelogID uuid.UUID `db:"timelog_id"`
+	Name      string    `db:"name"`
+}
+
+type TimelogTags []*TimelogTag
+
+// Load loads the tags of all timelogs matching the where condition. The condition is applied to the timelogs table.
+func (t *TimelogTags) Load(ctx context.Context, db sqlx.ExtContext, where string, args ...any) error {
+	q := `
+		SELECT tt.timelog_id, tg.name
+		FROM timelog_tags tt
+		JOIN tags tg ON tg.id = tt.tag_id
+	`
+	if where != "" {
+		q += " WHERE tt.timelog_id IN (SELECT id FROM timelogs WHERE " + where + ")"
+	}
+	q += " ORDER BY tg.name "
+
+	if err := sqlx.SelectContext(ctx, db, t, db.Rebind(q), args...); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadByTimelogs loads the tags of the timelogs with the given IDs.
+func (t *TimelogTags) LoadByTimelogs(ctx context.Context, db sqlx.ExtContext, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	q := `
+		SELECT tt.timelog_id, tg.name
+		FROM timelog_tags tt
+		JOIN tags tg ON tg.id = tt.tag_id
+		WHERE tt.timelog_id IN (?` + strings.Repeat(", ?", len(ids)-1) + `)
+		ORDER BY tg.name
+	`
+
+	args := make([]any, 0, len(ids))
+	for _, v := range ids {
+		args = append(args, v)
+	}
+
+	if err := sqlx.SelectContext(ctx, db, t, db.Rebind(q), args...); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ByTimelog returns the tag names grouped by the ID of the timelog.
+func (t TimelogTags) ByTimelog() map[uuid.UUID][]string {
+	res := make(map[uuid.UUID][]string)
+	for _, v := range t {
+		res[v.TimelogID] = append(res[v.TimelogID], v.Name)
+	}
+
+	return res
+}
+
+// saveTags replaces the tags assigned to the timelog by the current ones. Unknown tags are created.
+func (t *Timelog) saveTags(ctx context.Context, db sqlx.ExtContext) error {
+	q := db.Rebind(`
+		DELETE FROM timelog_tags
+		WHERE timelog_id = ?;
+	`)
+
+	if _, err := db.ExecContext(ctx, q, t.ID); err != nil {
+		return fmt.Errorf("failed to remove tags: %w", err)
+	}
+
+	for _, tag := range t.Tags {
+		q = db.Rebind(`
+			INSERT OR IGNORE INTO tags (name)
+			VALUES (?);
+		`)
+
+		if _, err := db.ExecContext(ctx, q, tag); err != nil {
+			return fmt.Errorf("failed to create tag %q: %w", tag, err)
+		}
+
+		q = db.Rebind(`
+			INSERT OR IGNORE INTO timelog_tags (timelog_id, tag_id)
+			SELECT ?, id FROM tags WHERE name = ?;
+		`)
+
+		if _, err := db.ExecContext(ctx, q, t.ID, tag); err != nil {
+			return fmt.Errorf("failed to assign tag %q: %w", tag, err)
+		}
+	}
+
+	return nil
+}
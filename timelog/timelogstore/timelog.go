@@ -2,6 +2,7 @@ package timelogstore
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"time"
@@ -13,7 +14,7 @@ import (
 
 const (
 	qSelect = `
-		SELECT id, start, stop, reason, location, created_at, modified_at
+		SELECT id, user_id, start, stop, reason, location, project_id, office_id, description, created_at, modified_at
         FROM timelogs
 	`
 )
@@ -34,17 +35,22 @@ var (
 
 // Timelog represents the timelog in the database.
 type Timelog struct {
-	ID         uuid.UUID  `db:"id"`
-	Start      time.Time  `db:"start"`
-	Stop       *time.Time `db:"stop"`
-	Reason     string     `db:"reason"`
-	Location   string     `db:"location"`
-	CreatedAt  time.Time  `db:"created_at"`
-	ModifiedAt time.Time  `db:"modified_at"`
+	ID          uuid.UUID  `db:"id"`
+	UserID      *uuid.UUID `db:"user_id"`
+	Start       time.Time  `db:"start"`
+	Stop        *time.Time `db:"stop"`
+	Reason      string     `db:"reason"`
+	Location    string     `db:"location"`
+	ProjectID   *uuid.UUID `db:"project_id"`
+	OfficeID    *uuid.UUID `db:"office_id"`
+	Description string     `db:"description"`
+	Tags        []string   `db:"-"`
+	CreatedAt   time.Time  `db:"created_at"`
+	ModifiedAt  time.Time  `db:"modified_at"`
 }
 
 // Create creates current object in the database.
-func (t *Timelog) Create(ctx context.Context, db *sqlx.DB) error {
+func (t *Timelog) Create(ctx context.Context, db sqlx.ExtContext) error {
 	if !t.IsValid() {
 		return ErrDataMissing
 	}
@@ -61,20 +67,26 @@ func (t *Timelog) Create(ctx context.Context, db *sqlx.DB) error {
 	}
 
 	q := db.Rebind(`
-		INSERT INTO timelogs (id, start, stop, reason, location) 
-		VALUES (?, ?, ?, ?, ?);
+		INSERT INTO timelogs (id, user_id, start, stop, reason, location, project_id, office_id, description) 
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
 	`)
 
-	_, err = db.ExecContext(ctx, q, t.ID, t.Start, t.Stop, t.Reason, t.Location)
+	_, err = db.ExecContext(
+		ctx, q, t.ID, t.UserID, t.Start, t.Stop, t.Reason, t.Location, t.ProjectID, t.OfficeID, t.Description,
+	)
 	if err != nil {
 		return fmt.Errorf("failed to create: %w", err)
 	}
 
+	if err := t.saveTags(ctx, db); err != nil {
+		return fmt.Errorf("failed to create: %w", err)
+	}
+
 	return t.Read(ctx, db)
 }
 
 // Read sets the timelog from database by given ID.
-func (t *Timelog) Read(ctx context.Context, db *sqlx.DB) error {
+func (t *Timelog) Read(ctx context.Context, db sqlx.ExtContext) error {
 	if t == nil || uuidutils.IsEmpty(t.ID) {
 		return ErrIDMissing
 	}
@@ -83,15 +95,22 @@ func (t *Timelog) Read(ctx context.Context, db *sqlx.DB) error {
 		qSelect + `
         WHERE id = ?;
     `)
-	if err := db.GetContext(ctx, t, q, t.ID); err != nil {
+	if err := sqlx.GetContext(ctx, db, t, q, t.ID); err != nil {
 		return fmt.Errorf("failed to read: %w", err)
 	}
 
+	tags := &TimelogTags{}
+	if err := tags.Load(ctx, db, "id = ?", t.ID); err != nil {
+		return fmt.Errorf("failed to read tags: %w", err)
+	}
+
+	t.Tags = tags.ByTimelog()[t.ID]
+
 	return nil
 }
 
 // Update changes the current object on the database by ID.
-func (t *Timelog) Update(ctx context.Context, db *sqlx.DB) error {
+func (t *Timelog) Update(ctx context.Context, db sqlx.ExtContext) error {
 	if !t.IsValid() {
 		return ErrDataMissing
 	}
@@ -102,33 +121,55 @@ func (t *Timelog) Update(ctx context.Context, db *sqlx.DB) error {
 
 	q := db.Rebind(`
 		UPDATE timelogs 
-		SET start = ?, stop = ?, reason = ?, location = ? 
+		SET start = ?, stop = ?, reason = ?, location = ?, project_id = ?, office_id = ?, description = ? 
 		WHERE id = ?;
 	`)
 
-	_, err := db.ExecContext(ctx, q, t.Start, t.Stop, t.Reason, t.Location, t.ID)
+	_, err := db.ExecContext(ctx, q, t.Start, t.Stop, t.Reason, t.Location, t.ProjectID, t.OfficeID, t.Description, t.ID)
 	if err != nil {
 		return fmt.Errorf("failed to update: %w", err)
 	}
 
+	if err := t.saveTags(ctx, db); err != nil {
+		return fmt.Errorf("failed to update: %w", err)
+	}
+
 	return t.Read(ctx, db)
 }
 
-// Delete removes the current object from database by its ID.
-func (t *Timelog) Delete(ctx context.Context, db *sqlx.DB) error {
+// Delete removes the current object from database by its ID. If the UserID is set, only a timelog of this user is
+// removed.
+func (t *Timelog) Delete(ctx context.Context, db sqlx.ExtContext) error {
 	if t == nil || uuidutils.IsEmpty(t.ID) {
 		return ErrIDMissing
 	}
 
+	w := "id = ?"
+	args := []any{t.ID}
+
+	if t.UserID != nil {
+		w += " AND user_id = ?"
+		args = append(args, *t.UserID)
+	}
+
 	q := db.Rebind(`
         DELETE FROM timelogs
-        WHERE id = ?
-    `)
+        WHERE ` + w)
+
+	res, err := db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
 
-	if _, err := db.ExecContext(ctx, q, t.ID); err != nil {
+	affected, err := res.RowsAffected()
+	if err != nil {
 		return fmt.Errorf("failed to delete: %w", err)
 	}
 
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
 	return nil
 }
 
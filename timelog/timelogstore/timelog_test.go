@@ -457,6 +457,7 @@ func TestTimelog_Delete(t *testing.T) {
 			prepare: &timelogstore.Timelog{
 				ID: testingutils.UUIDParse(t, "34dbbd09-af9e-4e33-9f12-42a4a9b24315"),
 			},
+			expectedErr: sql.ErrNoRows,
 		},
 	}
 
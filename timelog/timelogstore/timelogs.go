@@ -4,23 +4,108 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
 
 type Timelogs []*Timelog
 
-func (t *Timelogs) Load(ctx context.Context, db *sqlx.DB, where string, args ...any) error {
+func (t *Timelogs) Load(ctx context.Context, db sqlx.ExtContext, where string, args ...any) error {
 	q := qSelect
 	if where != "" {
 		q += " WHERE " + where
 	}
 
-	if err := db.SelectContext(ctx, t, db.Rebind(q), args...); err != nil {
+	if err := sqlx.SelectContext(ctx, db, t, db.Rebind(q), args...); err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			return err
 		}
 	}
 
+	tags := &TimelogTags{}
+	if err := tags.Load(ctx, db, where, args...); err != nil {
+		return err
+	}
+
+	byTimelog := tags.ByTimelog()
+	for _, v := range *t {
+		v.Tags = byTimelog[v.ID]
+	}
+
+	return nil
+}
+
+// streamBatchSize is the number of timelogs whose tags are loaded at once while streaming.
+const streamBatchSize = 500
+
+// Stream reads the timelogs matching the where condition ordered by start and passes them one by one to the callback,
+// so they are never loaded all at once. The tags are loaded in batches of streamBatchSize timelogs. It stops on the
+// first error returned by the callback.
+func (t *Timelogs) Stream(ctx context.Context, db sqlx.ExtContext, callback func(*Timelog) error, where string, args ...any) error {
+	q := qSelect
+	if where != "" {
+		q += " WHERE " + where
+	}
+	q += " ORDER BY start "
+
+	rows, err := db.QueryxContext(ctx, db.Rebind(q), args...)
+	if err != nil {
+		return fmt.Errorf("failed to query: %w", err)
+	}
+
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	batch := make(Timelogs, 0, streamBatchSize)
+
+	for rows.Next() {
+		v := &Timelog{} // nolint: exhaustivestruct
+		if err := rows.StructScan(v); err != nil {
+			return fmt.Errorf("failed to scan: %w", err)
+		}
+
+		batch = append(batch, v)
+		if len(batch) < streamBatchSize {
+			continue
+		}
+
+		if err := batch.pass(ctx, db, callback); err != nil {
+			return err
+		}
+
+		batch = batch[:0]
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return batch.pass(ctx, db, callback)
+}
+
+// pass loads the tags of the timelogs and passes them one by one to the callback.
+func (t Timelogs) pass(ctx context.Context, db sqlx.ExtContext, callback func(*Timelog) error) error {
+	ids := make([]uuid.UUID, 0, len(t))
+	for _, v := range t {
+		ids = append(ids, v.ID)
+	}
+
+	tags := &TimelogTags{}
+	if err := tags.LoadByTimelogs(ctx, db, ids); err != nil {
+		return err
+	}
+
+	byTimelog := tags.ByTimelog()
+	for _, v := range t {
+		v.Tags = byTimelog[v.ID]
+
+		if err := callback(v); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
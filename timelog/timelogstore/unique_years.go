@@ -4,23 +4,25 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
 
 type UniqueYears []string
 
-func (u *UniqueYears) Get(ctx context.Context, db *sqlx.DB) error {
+// Get loads the years of start and stop of all timelogs of the given user.
+func (u *UniqueYears) Get(ctx context.Context, db *sqlx.DB, userID uuid.UUID) error {
 	q := db.Rebind(`
 			SELECT DISTINCT strftime('%Y',start)
 			FROM timelogs
-			WHERE start IS NOT NULL
+			WHERE start IS NOT NULL AND user_id = ?
 		UNION
 			SELECT DISTINCT strftime('%Y',stop)
 			FROM timelogs
-			WHERE stop IS NOT NULL;
+			WHERE stop IS NOT NULL AND user_id = ?;
 	`)
 
-	if err := db.SelectContext(ctx, u, q); err != nil {
+	if err := db.SelectContext(ctx, u, q, userID, userID); err != nil {
 		return fmt.Errorf("failed to load unique years: %w", err)
 	}
 
@@ -0,0 +1,2 @@
+// Package tokenmapper provides functionality to read and persist personal API tokens.
+package tokenmapper
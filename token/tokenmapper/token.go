@@ -0,0 +1,176 @@
+package tokenmapper
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/ttrack_api/token/tokenmodel"
+	"github.com/rebel-l/ttrack_api/token/tokenstore"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+var (
+	// ErrLoadFromDB occurs if something went wrong on loading.
+	ErrLoadFromDB = errors.New("failed to load token from database")
+
+	// ErrNoData occurs if given model is nil.
+	ErrNoData = errors.New("token is nil")
+
+	// ErrSaveToDB occurs if something went wrong on saving.
+	ErrSaveToDB = errors.New("failed to save token to database")
+
+	// ErrDeleteFromDB occurs if something went wrong on deleting.
+	ErrDeleteFromDB = errors.New("failed to delete token from database")
+
+	// ErrNotFound occurs if record doesn't exist in database.
+	ErrNotFound = errors.New("token was not found")
+
+	// ErrInvalidToken occurs if a secret doesn't belong to any token or the token is expired.
+	ErrInvalidToken = errors.New("token is invalid")
+)
+
+// Mapper provides methods to load and persist token models.
+type Mapper struct {
+	db *sqlx.DB
+}
+
+// New returns a new mapper.
+func New(db *sqlx.DB) *Mapper {
+	return &Mapper{db: db}
+}
+
+// Create persists a new token for the user of the context. If the context is limited by scopes, the token is limited
+// to them as well and fails with tokenmodel.ErrScopeNotGranted for other scopes. The returned model contains the
+// secret, which is the only time it is available.
+func (m *Mapper) Create(ctx context.Context, model *tokenmodel.Token) (*tokenmodel.Token, error) {
+	if model == nil {
+		return nil, ErrNoData
+	}
+
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSaveToDB, err)
+	}
+
+	if granted, ok := tokenmodel.ScopesFromContext(ctx); ok {
+		if err := model.LimitScopes(granted); err != nil {
+			return nil, err // nolint: wrapcheck
+		}
+	}
+
+	secret, err := tokenmodel.NewSecret()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	s := &tokenstore.Token{ // nolint: exhaustivestruct
+		UserID:    userID,
+		Name:      model.Name,
+		Hash:      tokenmodel.Hash(secret),
+		Scopes:    joinScopes(model.Scopes),
+		ExpiresAt: model.ExpiresAt,
+	}
+
+	if err := s.Create(ctx, m.db); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	res := StoreToModel(s)
+	res.Secret = secret
+
+	return res, nil
+}
+
+// Authenticate returns the token the secret belongs to. It fails with ErrInvalidToken if there is no such token or
+// it is expired.
+func (m *Mapper) Authenticate(ctx context.Context, secret string) (*tokenmodel.Token, error) {
+	if secret == "" {
+		return nil, ErrInvalidToken
+	}
+
+	s := &tokenstore.Token{Hash: tokenmodel.Hash(secret)} // nolint: exhaustivestruct
+
+	if err := s.ReadByHash(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvalidToken
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	model := StoreToModel(s)
+	if model.IsExpired(time.Now()) {
+		return nil, fmt.Errorf("%w: expired at %s", ErrInvalidToken, model.ExpiresAt.Format(time.RFC3339))
+	}
+
+	return model, nil
+}
+
+// Delete revokes the token with the given ID. Only tokens of the user of the context can be revoked, others are not
+// found.
+func (m *Mapper) Delete(ctx context.Context, id uuid.UUID) error {
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDeleteFromDB, err)
+	}
+
+	s := &tokenstore.Token{ID: id} // nolint: exhaustivestruct
+
+	if err := s.Read(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	} else if err != nil {
+		return fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	if s.UserID != userID {
+		return ErrNotFound
+	}
+
+	if err := s.Delete(ctx, m.db); err != nil {
+		return fmt.Errorf("%w: %v", ErrDeleteFromDB, err)
+	}
+
+	return nil
+}
+
+// StoreToModel returns a model based on the given store object. It maps all properties from store to model except
+// the hash of the secret.
+func StoreToModel(s *tokenstore.Token) *tokenmodel.Token {
+	if s == nil {
+		return &tokenmodel.Token{} // nolint: exhaustivestruct
+	}
+
+	return &tokenmodel.Token{ // nolint: exhaustivestruct
+		ID:         s.ID,
+		UserID:     s.UserID,
+		Name:       s.Name,
+		Scopes:     splitScopes(s.Scopes),
+		ExpiresAt:  s.ExpiresAt,
+		CreatedAt:  s.CreatedAt,
+		ModifiedAt: s.ModifiedAt,
+	}
+}
+
+// joinScopes returns the scopes separated by spaces as stored in the database. Without scopes it returns nil.
+func joinScopes(scopes []string) *string {
+	if scopes == nil {
+		return nil
+	}
+
+	joined := strings.Join(scopes, " ")
+
+	return &joined
+}
+
+// splitScopes returns the scopes stored separated by spaces. Without scopes it returns nil.
+func splitScopes(scopes *string) []string {
+	if scopes == nil {
+		return nil
+	}
+
+	return append([]string{}, strings.Fields(*scopes)...)
+}
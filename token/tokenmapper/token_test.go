@@ -0,0 +1,157 @@
+package tokenmapper_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rebel-l/go-utils/osutils"
+	"github.com/rebel-l/go-utils/testingutils"
+	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/config"
+	"github.com/rebel-l/ttrack_api/token/tokenmapper"
+	"github.com/rebel-l/ttrack_api/token/tokenmodel"
+	"github.com/rebel-l/ttrack_api/user/usermapper"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func setup(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+
+	// 0. init path
+	storagePath := filepath.Join(".", "..", "..", "storage", "test_token", name)
+	scriptPath := filepath.Join(".", "..", "..", "scripts", "sql", "sqlite")
+	conf := &config.Database{
+		StoragePath:       &storagePath,
+		SchemaScriptsPath: &scriptPath,
+	}
+
+	// 1. clean up
+	if osutils.FileOrPathExists(conf.GetStoragePath()) {
+		if err := os.RemoveAll(conf.GetStoragePath()); err != nil {
+			t.Fatalf("failed to cleanup test files: %v", err)
+		}
+	}
+
+	// 2. init database
+	db, err := bootstrap.Database(conf, "0.0.0", false)
+	if err != nil {
+		t.Fatalf("No error expected: %v", err)
+	}
+
+	return db
+}
+
+func TestMapper_CreateAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperCreateAuthenticate")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	user, err := usermapper.New(db).Save(context.Background(), &usermodel.User{Name: "Jane"})
+	if err != nil {
+		t.Fatalf("failed to prepare user: %v", err)
+	}
+
+	mapper := tokenmapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), user.ID)
+	past := time.Now().Add(-time.Hour)
+
+	// 2. test
+	if _, err := mapper.Create(ctx, nil); !errors.Is(err, tokenmapper.ErrNoData) {
+		t.Errorf("expected error '%v' but got '%v'", tokenmapper.ErrNoData, err)
+	}
+
+	created, err := mapper.Create(ctx, &tokenmodel.Token{Name: "laptop"})
+	if err != nil {
+		t.Fatalf("expected no error on create but got '%v'", err)
+	}
+
+	if created.Secret == "" || created.UserID != user.ID {
+		t.Errorf("expected token with secret for user %s but got '%+v'", user.ID, created)
+	}
+
+	authenticated, err := mapper.Authenticate(context.Background(), created.Secret)
+	if err != nil {
+		t.Fatalf("expected no error on authenticate but got '%v'", err)
+	}
+
+	if authenticated.ID != created.ID || authenticated.UserID != user.ID || authenticated.Secret != "" {
+		t.Errorf("expected token '%+v' without secret but got '%+v'", created, authenticated)
+	}
+
+	expired, err := mapper.Create(ctx, &tokenmodel.Token{Name: "old laptop", ExpiresAt: &past})
+	if err != nil {
+		t.Fatalf("expected no error on create but got '%v'", err)
+	}
+
+	for _, secret := range []string{"", "ttrack_unknown", expired.Secret} {
+		if _, err := mapper.Authenticate(context.Background(), secret); !errors.Is(err, tokenmapper.ErrInvalidToken) {
+			t.Errorf("expected error '%v' for secret %q but got '%v'", tokenmapper.ErrInvalidToken, secret, err)
+		}
+	}
+}
+
+func TestMapper_Delete(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperDelete")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	user, err := usermapper.New(db).Save(context.Background(), &usermodel.User{Name: "Jane"})
+	if err != nil {
+		t.Fatalf("failed to prepare user: %v", err)
+	}
+
+	mapper := tokenmapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), user.ID)
+
+	token, err := mapper.Create(ctx, &tokenmodel.Token{Name: "laptop"})
+	if err != nil {
+		t.Fatalf("failed to prepare token: %v", err)
+	}
+
+	// 2. test
+	notExisting := testingutils.UUIDParse(t, "5f0d3c1e-7b1a-4c8e-9d2f-6a5b4c3d2e1f")
+	if err := mapper.Delete(ctx, notExisting); !errors.Is(err, tokenmapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", tokenmapper.ErrNotFound, err)
+	}
+
+	// tokens of other users can't be revoked
+	if err := mapper.Delete(usermodel.NewContext(context.Background(), usermodel.DefaultID), token.ID); !errors.Is(err, tokenmapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", tokenmapper.ErrNotFound, err)
+	}
+
+	if err := mapper.Delete(ctx, token.ID); err != nil {
+		t.Fatalf("expected no error on delete but got '%v'", err)
+	}
+
+	if _, err := mapper.Authenticate(ctx, token.Secret); !errors.Is(err, tokenmapper.ErrInvalidToken) {
+		t.Errorf("expected revoked token to be invalid but got error '%v'", err)
+	}
+}
@@ -0,0 +1,18 @@
+package tokenmodel
+
+import "context"
+
+type scopesKey struct{}
+
+// NewScopesContext returns a copy of the context carrying the scopes the request is limited to.
+func NewScopesContext(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, scopesKey{}, scopes)
+}
+
+// ScopesFromContext returns the scopes the request is limited to. It returns false if the request is not limited by
+// scopes.
+func ScopesFromContext(ctx context.Context) ([]string, bool) {
+	scopes, ok := ctx.Value(scopesKey{}).([]string)
+
+	return scopes, ok
+}
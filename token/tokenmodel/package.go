@@ -0,0 +1,2 @@
+// Package tokenmodel provides functionality and business logic to manage personal API tokens.
+package tokenmodel
@@ -0,0 +1,147 @@
+package tokenmodel
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxLengthName defines the maximum number of characters of the token name.
+	MaxLengthName = 100
+
+	// SecretPrefix is the prefix of every secret to make tokens recognisable, e.g. by secret scanners.
+	SecretPrefix = "ttrack_"
+
+	secretBytes = 32
+)
+
+var (
+	// ErrDecodeJSON occurs if a string is not in JSON format.
+	ErrDecodeJSON = errors.New("failed to decode JSON")
+
+	// ErrValidationNameMandatory occurs during validation if the name wasn't set.
+	ErrValidationNameMandatory = errors.New("name should not be empty")
+
+	// ErrValidationTooLong occurs during validation if a value exceeds its maximum length.
+	ErrValidationTooLong = errors.New("value is too long")
+
+	// ErrValidationExpired occurs during validation if the expiry time is not in the future.
+	ErrValidationExpired = errors.New("expiry time should be in the future")
+
+	// ErrGenerateSecret occurs if no random secret could be generated.
+	ErrGenerateSecret = errors.New("failed to generate secret")
+
+	// ErrScopeNotGranted occurs if a token should get a scope the creating request is not granted itself.
+	ErrScopeNotGranted = errors.New("scope is not granted")
+)
+
+// Token represents a personal API token of a user. Only the hash of the secret is stored, so the secret is returned
+// once on creation and can't be restored afterwards. Tokens without ExpiresAt are valid until they are revoked. Tokens
+// without Scopes are not limited, otherwise they grant only access to routes requiring these scopes.
+type Token struct {
+	ID         uuid.UUID  `json:"ID"`
+	UserID     uuid.UUID  `json:"UserID"`
+	Name       string     `json:"Name"`
+	Secret     string     `json:"Token,omitempty"`
+	Scopes     []string   `json:"Scopes"`
+	ExpiresAt  *time.Time `json:"ExpiresAt,omitempty"`
+	CreatedAt  time.Time  `json:"CreatedAt"`
+	ModifiedAt time.Time  `json:"ModifiedAt"`
+}
+
+// DecodeJSON converts JSON data to struct.
+func (t *Token) DecodeJSON(reader io.Reader) error {
+	if t == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(reader)
+	if err := decoder.Decode(t); err != nil {
+		return fmt.Errorf("%w: %v", ErrDecodeJSON, err)
+	}
+
+	return nil
+}
+
+// Validate is validating the attributes of the struct to valid values. If the validation fails it returns the reason
+// why it failed in the error message.
+func (t *Token) Validate() error {
+	if t.Name == "" {
+		return ErrValidationNameMandatory
+	}
+
+	if len([]rune(t.Name)) > MaxLengthName {
+		return fmt.Errorf("%w: name has more than %d characters", ErrValidationTooLong, MaxLengthName)
+	}
+
+	if t.ExpiresAt != nil && !t.ExpiresAt.After(time.Now()) {
+		return fmt.Errorf("%w: %s", ErrValidationExpired, t.ExpiresAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// LimitScopes restricts the token to the scopes granted to the request creating it. Without scopes the token gets all
+// granted ones, otherwise it fails with ErrScopeNotGranted if one of them is not granted.
+func (t *Token) LimitScopes(granted []string) error {
+	if t.Scopes == nil {
+		t.Scopes = append([]string{}, granted...)
+
+		return nil
+	}
+
+	for _, v := range t.Scopes {
+		if !contains(granted, v) {
+			return fmt.Errorf("%w: %s", ErrScopeNotGranted, v)
+		}
+	}
+
+	return nil
+}
+
+// IsScoped returns true if the token grants only access to routes requiring its scopes.
+func (t *Token) IsScoped() bool {
+	return t.Scopes != nil
+}
+
+// IsExpired returns true if the token has an expiry time which has passed at the given time.
+func (t *Token) IsExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && !t.ExpiresAt.After(now)
+}
+
+// NewSecret returns a new random secret for a token.
+func NewSecret() (string, error) {
+	b := make([]byte, secretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrGenerateSecret, err)
+	}
+
+	return SecretPrefix + hex.EncodeToString(b), nil
+}
+
+// Hash returns the hash of the secret as it is stored in the database. The secrets are long random strings, so a
+// single SHA-256 is sufficient and allows to look up tokens by their hash.
+func Hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// contains returns true if the value is one of the values.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
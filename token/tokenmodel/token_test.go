@@ -0,0 +1,108 @@
+package tokenmodel_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rebel-l/ttrack_api/token/tokenmodel"
+)
+
+func TestToken_Validate(t *testing.T) {
+	t.Parallel()
+
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	testCases := []struct {
+		name        string
+		token       *tokenmodel.Token
+		expectedErr error
+	}{
+		{
+			name:        "name missing",
+			token:       &tokenmodel.Token{},
+			expectedErr: tokenmodel.ErrValidationNameMandatory,
+		},
+		{
+			name:        "name too long",
+			token:       &tokenmodel.Token{Name: strings.Repeat("a", tokenmodel.MaxLengthName+1)},
+			expectedErr: tokenmodel.ErrValidationTooLong,
+		},
+		{
+			name:        "expired",
+			token:       &tokenmodel.Token{Name: "laptop", ExpiresAt: &past},
+			expectedErr: tokenmodel.ErrValidationExpired,
+		},
+		{
+			name:  "expires in future",
+			token: &tokenmodel.Token{Name: "laptop", ExpiresAt: &future},
+		},
+		{
+			name:  "never expires",
+			token: &tokenmodel.Token{Name: "laptop"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := testCase.token.Validate(); !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestToken_LimitScopes(t *testing.T) {
+	t.Parallel()
+
+	granted := []string{"timelogs:read", "tokens:write"}
+
+	inherit := &tokenmodel.Token{}
+	if err := inherit.LimitScopes(granted); err != nil || !reflect.DeepEqual(granted, inherit.Scopes) {
+		t.Errorf("expected scopes %v without error but got %v and '%v'", granted, inherit.Scopes, err)
+	}
+
+	subset := &tokenmodel.Token{Scopes: []string{"timelogs:read"}}
+	if err := subset.LimitScopes(granted); err != nil || !subset.IsScoped() {
+		t.Errorf("expected scoped token without error but got '%v'", err)
+	}
+
+	escalate := &tokenmodel.Token{Scopes: []string{"reports:read"}}
+	if err := escalate.LimitScopes(granted); !errors.Is(err, tokenmodel.ErrScopeNotGranted) {
+		t.Errorf("expected error '%v' but got '%v'", tokenmodel.ErrScopeNotGranted, err)
+	}
+}
+
+func TestNewSecret(t *testing.T) {
+	t.Parallel()
+
+	first, err := tokenmodel.NewSecret()
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	second, err := tokenmodel.NewSecret()
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if !strings.HasPrefix(first, tokenmodel.SecretPrefix) {
+		t.Errorf("expected secret %q to start with %q", first, tokenmodel.SecretPrefix)
+	}
+
+	if first == second {
+		t.Errorf("expected different secrets but got %q twice", first)
+	}
+
+	if tokenmodel.Hash(first) == first || tokenmodel.Hash(first) != tokenmodel.Hash(first) ||
+		tokenmodel.Hash(first) == tokenmodel.Hash(second) {
+		t.Errorf("expected hash to be stable and different per secret")
+	}
+}
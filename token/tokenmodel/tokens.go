@@ -0,0 +1,3 @@
+package tokenmodel
+
+type Tokens []*Token
@@ -0,0 +1,2 @@
+// Package tokenstore contains the CRUD operations for personal API tokens on the database.
+package tokenstore
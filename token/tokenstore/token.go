@@ -0,0 +1,136 @@
+package tokenstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+)
+
+const (
+	qSelect = `
+		SELECT id, user_id, name, hash, scopes, expires_at, created_at, modified_at
+        FROM tokens
+	`
+)
+
+var (
+	// ErrIDMissing will be thrown if an ID is expected but not set.
+	ErrIDMissing = errors.New("id is mandatory for this operation")
+
+	// ErrCreatingID will be thrown if creating an ID failed.
+	ErrCreatingID = errors.New("id creation failed")
+
+	// ErrIDIsSet will be thrown if no ID is expected but already set.
+	ErrIDIsSet = errors.New("id should be not set for this operation, use update instead")
+
+	// ErrDataMissing will be thrown if mandatory data is not set.
+	ErrDataMissing = errors.New("no data or mandatory data missing")
+)
+
+// Token represents the token in the database. Scopes are separated by spaces, tokens without scopes are not limited.
+type Token struct {
+	ID         uuid.UUID  `db:"id"`
+	UserID     uuid.UUID  `db:"user_id"`
+	Name       string     `db:"name"`
+	Hash       string     `db:"hash"`
+	Scopes     *string    `db:"scopes"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+	ModifiedAt time.Time  `db:"modified_at"`
+}
+
+// Create creates current object in the database.
+func (t *Token) Create(ctx context.Context, db sqlx.ExtContext) error {
+	if !t.IsValid() {
+		return ErrDataMissing
+	}
+
+	if !uuidutils.IsEmpty(t.ID) {
+		return ErrIDIsSet
+	}
+
+	var err error
+
+	t.ID, err = uuid.NewRandom()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCreatingID, err)
+	}
+
+	q := db.Rebind(`
+		INSERT INTO tokens (id, user_id, name, hash, scopes, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?);
+	`)
+
+	_, err = db.ExecContext(ctx, q, t.ID, t.UserID, t.Name, t.Hash, t.Scopes, t.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to create: %w", err)
+	}
+
+	return t.Read(ctx, db)
+}
+
+// Read sets the token from database by given ID.
+func (t *Token) Read(ctx context.Context, db sqlx.ExtContext) error {
+	if t == nil || uuidutils.IsEmpty(t.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE id = ?;
+    `)
+	if err := sqlx.GetContext(ctx, db, t, q, t.ID); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// ReadByHash sets the token from database by given hash.
+func (t *Token) ReadByHash(ctx context.Context, db sqlx.ExtContext) error {
+	if t == nil || t.Hash == "" {
+		return ErrDataMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE hash = ?;
+    `)
+	if err := sqlx.GetContext(ctx, db, t, q, t.Hash); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the current object from database by its ID.
+func (t *Token) Delete(ctx context.Context, db sqlx.ExtContext) error {
+	if t == nil || uuidutils.IsEmpty(t.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+        DELETE FROM tokens
+        WHERE id = ?
+    `)
+
+	if _, err := db.ExecContext(ctx, q, t.ID); err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	return nil
+}
+
+// IsValid returns true if all mandatory fields are set.
+func (t *Token) IsValid() bool {
+	if t == nil || uuidutils.IsEmpty(t.UserID) || t.Name == "" || t.Hash == "" {
+		return false
+	}
+
+	return true
+}
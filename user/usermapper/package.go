@@ -0,0 +1,2 @@
+// Package usermapper provides functionality to read and persist users.
+package usermapper
@@ -0,0 +1,231 @@
+package usermapper
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+	"github.com/rebel-l/ttrack_api/team/teamstore"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+	"github.com/rebel-l/ttrack_api/user/userstore"
+)
+
+var (
+	// ErrLoadFromDB occurs if something went wrong on loading.
+	ErrLoadFromDB = errors.New("failed to load user from database")
+
+	// ErrNoData occurs if given model is nil.
+	ErrNoData = errors.New("user is nil")
+
+	// ErrSaveToDB occurs if something went wrong on saving.
+	ErrSaveToDB = errors.New("failed to save user to database")
+
+	// ErrDeleteFromDB occurs if something went wrong on deleting.
+	ErrDeleteFromDB = errors.New("failed to delete user from database")
+
+	// ErrNotFound occurs if record doesn't exist in database.
+	ErrNotFound = errors.New("user was not found")
+
+	// ErrNameExists occurs if there is already another user with the same name.
+	ErrNameExists = errors.New("there is already a user with this name")
+
+	// ErrDeleteDefault occurs if the default user should be deleted.
+	ErrDeleteDefault = errors.New("the default user can't be deleted")
+
+	// ErrTeamNotFound occurs if the user references a team which doesn't exist.
+	ErrTeamNotFound = errors.New("team of user was not found")
+
+	// ErrNotLead occurs if a user should access the data of another user they are not lead of.
+	ErrNotLead = errors.New("only leads of the team of the user have access")
+
+	// ErrHasTimelogs occurs if a user should be deleted who still owns timelogs.
+	ErrHasTimelogs = errors.New("the user still has timelogs")
+)
+
+// Mapper provides methods to load and persist user models.
+type Mapper struct {
+	db *sqlx.DB
+}
+
+// New returns a new mapper.
+func New(db *sqlx.DB) *Mapper {
+	return &Mapper{db: db}
+}
+
+// Load returns a user model loaded from database by ID.
+func (m *Mapper) Load(ctx context.Context, id uuid.UUID) (*usermodel.User, error) {
+	s := &userstore.User{ID: id} // nolint: exhaustivestruct
+
+	if err := s.Read(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// LoadByName returns a user model loaded from database by name.
+func (m *Mapper) LoadByName(ctx context.Context, name string) (*usermodel.User, error) {
+	s := &userstore.User{Name: name} // nolint: exhaustivestruct
+
+	if err := s.ReadByName(ctx, m.db); errors.Is(err, sql.ErrNoRows) || errors.Is(err, userstore.ErrDataMissing) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// LoadForLead returns the user by ID if the lead has access to their data. Users have access to their own data, leads
+// of a team additionally to the data of the members of their team. It fails with ErrNotLead otherwise, also if one of
+// the users doesn't exist.
+func (m *Mapper) LoadForLead(ctx context.Context, leadID, id uuid.UUID) (*usermodel.User, error) {
+	user, err := m.Load(ctx, id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, ErrNotLead
+	} else if err != nil {
+		return nil, err
+	}
+
+	if leadID == id {
+		return user, nil
+	}
+
+	lead, err := m.Load(ctx, leadID)
+	if errors.Is(err, ErrNotFound) {
+		return nil, ErrNotLead
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !lead.IsLeadOf(user) {
+		return nil, ErrNotLead
+	}
+
+	return user, nil
+}
+
+// Save persists (create or update) the model and returns the changed data (id, createdAt or modifiedAt). It fails
+// with ErrNameExists if there is another user with the same name and with ErrTeamNotFound if the team doesn't exist.
+// Users without role become members.
+func (m *Mapper) Save(ctx context.Context, model *usermodel.User) (*usermodel.User, error) {
+	if model == nil {
+		return nil, ErrNoData
+	}
+
+	if model.Role == "" {
+		model.Role = usermodel.RoleMember
+	}
+
+	s := modelToStore(model)
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	existing := &userstore.Users{}
+	if err := existing.Load(ctx, tx, "name = ? AND id != ?", s.Name, s.ID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	if len(*existing) > 0 {
+		return nil, ErrNameExists
+	}
+
+	if model.TeamID != nil {
+		t := &teamstore.Team{ID: *model.TeamID} // nolint: exhaustivestruct
+		if err := t.Read(ctx, tx); errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTeamNotFound
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+		}
+	}
+
+	if uuidutils.IsEmpty(model.ID) {
+		if err := s.Create(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	} else {
+		if err := s.Update(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// Delete removes a model from database by ID. The default user and users still owning timelogs can't be deleted.
+func (m *Mapper) Delete(ctx context.Context, id uuid.UUID) error {
+	if id == usermodel.DefaultID {
+		return ErrDeleteDefault
+	}
+
+	s := &userstore.User{ID: id} // nolint: exhaustivestruct
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDeleteFromDB, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	hasTimelogs, err := s.HasTimelogs(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDeleteFromDB, err)
+	}
+
+	if hasTimelogs {
+		return ErrHasTimelogs
+	}
+
+	if err := s.Delete(ctx, tx); err != nil {
+		return fmt.Errorf("%w: %v", ErrDeleteFromDB, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", ErrDeleteFromDB, err)
+	}
+
+	return nil
+}
+
+// StoreToModel returns a model based on the given store object. It maps all properties from store to model.
+func StoreToModel(s *userstore.User) *usermodel.User {
+	if s == nil {
+		return &usermodel.User{} // nolint: exhaustivestruct
+	}
+
+	return &usermodel.User{
+		ID:         s.ID,
+		Name:       s.Name,
+		Role:       s.Role,
+		TeamID:     s.TeamID,
+		CreatedAt:  s.CreatedAt,
+		ModifiedAt: s.ModifiedAt,
+	}
+}
+
+// modelToStore returns a store based on the given model object. It maps all properties from model to store.
+func modelToStore(m *usermodel.User) *userstore.User {
+	return &userstore.User{
+		ID:         m.ID,
+		Name:       m.Name,
+		Role:       m.Role,
+		TeamID:     m.TeamID,
+		CreatedAt:  m.CreatedAt,
+		ModifiedAt: m.ModifiedAt,
+	}
+}
@@ -0,0 +1,355 @@
+package usermapper_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rebel-l/go-utils/osutils"
+	"github.com/rebel-l/go-utils/testingutils"
+	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/config"
+	"github.com/rebel-l/ttrack_api/team/teammapper"
+	"github.com/rebel-l/ttrack_api/team/teammodel"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmapper"
+	"github.com/rebel-l/ttrack_api/timelog/timelogmodel"
+	"github.com/rebel-l/ttrack_api/user/usermapper"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func setup(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+
+	// 0. init path
+	storagePath := filepath.Join(".", "..", "..", "storage", "test_user", name)
+	scriptPath := filepath.Join(".", "..", "..", "scripts", "sql", "sqlite")
+	conf := &config.Database{
+		StoragePath:       &storagePath,
+		SchemaScriptsPath: &scriptPath,
+	}
+
+	// 1. clean up
+	if osutils.FileOrPathExists(conf.GetStoragePath()) {
+		if err := os.RemoveAll(conf.GetStoragePath()); err != nil {
+			t.Fatalf("failed to cleanup test files: %v", err)
+		}
+	}
+
+	// 2. init database
+	db, err := bootstrap.Database(conf, "0.0.0", false)
+	if err != nil {
+		t.Fatalf("No error expected: %v", err)
+	}
+
+	return db
+}
+
+func TestMapper_SaveLoad(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperSaveLoad")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := usermapper.New(db)
+	ctx := context.Background()
+
+	// 2. test
+	if _, err := mapper.Save(ctx, nil); !errors.Is(err, usermapper.ErrNoData) {
+		t.Errorf("expected error '%v' but got '%v'", usermapper.ErrNoData, err)
+	}
+
+	if _, err := mapper.Load(ctx, testingutils.UUIDParse(t, "5f0d3c1e-7b1a-4c8e-9d2f-6a5b4c3d2e1f")); !errors.Is(err, usermapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", usermapper.ErrNotFound, err)
+	}
+
+	defaultUser, err := mapper.Load(ctx, usermodel.DefaultID)
+	if err != nil {
+		t.Fatalf("expected default user to exist but got error '%v'", err)
+	}
+
+	if defaultUser.Name != usermodel.DefaultName {
+		t.Errorf("expected default user to be named %q but got %q", usermodel.DefaultName, defaultUser.Name)
+	}
+
+	if _, err := mapper.Save(ctx, &usermodel.User{Name: usermodel.DefaultName}); !errors.Is(err, usermapper.ErrNameExists) {
+		t.Errorf("expected error '%v' but got '%v'", usermapper.ErrNameExists, err)
+	}
+
+	saved, err := mapper.Save(ctx, &usermodel.User{Name: "Jane"})
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	saved.Name = "Jane Doe"
+
+	if _, err := mapper.Save(ctx, saved); err != nil {
+		t.Fatalf("expected no error on update but got '%v'", err)
+	}
+
+	loaded, err := mapper.Load(ctx, saved.ID)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if loaded.Name != "Jane Doe" {
+		t.Errorf("expected user '%v' but got '%v'", saved, loaded)
+	}
+
+	all, err := mapper.LoadAll(ctx)
+	if err != nil {
+		t.Fatalf("expected no error on load all but got '%v'", err)
+	}
+
+	if len(all) != 2 {
+		t.Errorf("expected 2 users but got %d", len(all))
+	}
+}
+
+func TestMapper_Delete(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperDelete")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := usermapper.New(db)
+	ctx := context.Background()
+
+	user, err := mapper.Save(ctx, &usermodel.User{Name: "Jane"})
+	if err != nil {
+		t.Fatalf("failed to prepare user: %v", err)
+	}
+
+	ctxUser := usermodel.NewContext(ctx, user.ID)
+	tMapper := timelogmapper.New(db)
+
+	timelog, err := tMapper.Save(ctxUser, &timelogmodel.Timelog{
+		Start:    time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC),
+		Reason:   timelogmodel.ReasonWork,
+		Location: timelogmodel.LocationHome,
+	})
+	if err != nil {
+		t.Fatalf("failed to prepare timelog: %v", err)
+	}
+
+	// 2. test
+	if err := mapper.Delete(ctx, usermodel.DefaultID); !errors.Is(err, usermapper.ErrDeleteDefault) {
+		t.Errorf("expected error '%v' but got '%v'", usermapper.ErrDeleteDefault, err)
+	}
+
+	if err := mapper.Delete(ctx, user.ID); !errors.Is(err, usermapper.ErrHasTimelogs) {
+		t.Errorf("expected error '%v' but got '%v'", usermapper.ErrHasTimelogs, err)
+	}
+
+	if err := tMapper.Delete(ctxUser, timelog.ID); err != nil {
+		t.Fatalf("failed to delete timelog: %v", err)
+	}
+
+	if err := mapper.Delete(ctx, user.ID); err != nil {
+		t.Fatalf("expected no error on delete but got '%v'", err)
+	}
+
+	if _, err := mapper.Load(ctx, user.ID); !errors.Is(err, usermapper.ErrNotFound) {
+		t.Errorf("expected that user was deleted but got error '%v'", err)
+	}
+}
+
+func TestMapper_SaveRoleTeam(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperSaveRoleTeam")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := usermapper.New(db)
+	ctx := context.Background()
+
+	team, err := teammapper.New(db).Save(ctx, &teammodel.Team{Name: "Backend"})
+	if err != nil {
+		t.Fatalf("failed to prepare team: %v", err)
+	}
+
+	// 2. test
+	defaultUser, err := mapper.Load(ctx, usermodel.DefaultID)
+	if err != nil {
+		t.Fatalf("expected default user to exist but got error '%v'", err)
+	}
+
+	if defaultUser.Role != usermodel.RoleAdmin {
+		t.Errorf("expected default user to be %s but got %q", usermodel.RoleAdmin, defaultUser.Role)
+	}
+
+	notExisting := testingutils.UUIDParse(t, "5f0d3c1e-7b1a-4c8e-9d2f-6a5b4c3d2e1f")
+	_, err = mapper.Save(ctx, &usermodel.User{Name: "Jane", TeamID: &notExisting})
+	if !errors.Is(err, usermapper.ErrTeamNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", usermapper.ErrTeamNotFound, err)
+	}
+
+	saved, err := mapper.Save(ctx, &usermodel.User{Name: "Jane"})
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	if saved.Role != usermodel.RoleMember || saved.TeamID != nil {
+		t.Errorf("expected member without team but got '%+v'", saved)
+	}
+
+	saved.Role = usermodel.RoleLead
+	saved.TeamID = &team.ID
+
+	if _, err := mapper.Save(ctx, saved); err != nil {
+		t.Fatalf("expected no error on update but got '%v'", err)
+	}
+
+	loaded, err := mapper.Load(ctx, saved.ID)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if loaded.Role != usermodel.RoleLead || loaded.TeamID == nil || *loaded.TeamID != team.ID {
+		t.Errorf("expected lead of team %s but got '%+v'", team.ID, loaded)
+	}
+}
+
+func TestMapper_LoadForLead(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperLoadForLead")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := usermapper.New(db)
+	ctx := context.Background()
+
+	tMapper := teammapper.New(db)
+
+	team, err := tMapper.Save(ctx, &teammodel.Team{Name: "Backend"})
+	if err != nil {
+		t.Fatalf("failed to prepare team: %v", err)
+	}
+
+	otherTeam, err := tMapper.Save(ctx, &teammodel.Team{Name: "Frontend"})
+	if err != nil {
+		t.Fatalf("failed to prepare team: %v", err)
+	}
+
+	users := make(map[string]*usermodel.User)
+
+	for _, v := range []*usermodel.User{
+		{Name: "lead", Role: usermodel.RoleLead, TeamID: &team.ID},
+		{Name: "member", TeamID: &team.ID},
+		{Name: "other member", TeamID: &team.ID},
+		{Name: "other lead", Role: usermodel.RoleLead, TeamID: &otherTeam.ID},
+		{Name: "admin", Role: usermodel.RoleAdmin},
+	} {
+		saved, err := mapper.Save(ctx, v)
+		if err != nil {
+			t.Fatalf("failed to prepare user: %v", err)
+		}
+
+		users[saved.Name] = saved
+	}
+
+	// 2. test
+	testCases := []struct {
+		name        string
+		lead        uuid.UUID
+		user        uuid.UUID
+		expectedErr error
+	}{
+		{
+			name: "lead of team",
+			lead: users["lead"].ID,
+			user: users["member"].ID,
+		},
+		{
+			name: "own data",
+			lead: users["member"].ID,
+			user: users["member"].ID,
+		},
+		{
+			name:        "member of same team",
+			lead:        users["other member"].ID,
+			user:        users["member"].ID,
+			expectedErr: usermapper.ErrNotLead,
+		},
+		{
+			name:        "lead of other team",
+			lead:        users["other lead"].ID,
+			user:        users["member"].ID,
+			expectedErr: usermapper.ErrNotLead,
+		},
+		{
+			name:        "admin",
+			lead:        users["admin"].ID,
+			user:        users["member"].ID,
+			expectedErr: usermapper.ErrNotLead,
+		},
+		{
+			name:        "user not existing",
+			lead:        users["lead"].ID,
+			user:        uuid.New(),
+			expectedErr: usermapper.ErrNotLead,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := mapper.LoadForLead(ctx, testCase.lead, testCase.user)
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+
+			if testCase.expectedErr == nil && got.ID != testCase.user {
+				t.Errorf("expected user %s but got %s", testCase.user, got.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package usermapper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+	"github.com/rebel-l/ttrack_api/user/userstore"
+)
+
+// LoadAll returns all users ordered by name.
+func (m *Mapper) LoadAll(ctx context.Context) (usermodel.Users, error) {
+	s := &userstore.Users{}
+
+	if err := s.Load(ctx, m.db, ""); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	models := usermodel.Users{}
+	for _, v := range *s {
+		models = append(models, StoreToModel(v))
+	}
+
+	return models, nil
+}
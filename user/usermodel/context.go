@@ -0,0 +1,30 @@
+package usermodel
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rebel-l/go-utils/uuidutils"
+)
+
+// ErrNoUser occurs if the context carries no authenticated user.
+var ErrNoUser = errors.New("no authenticated user")
+
+type contextKey struct{}
+
+// NewContext returns a copy of the context carrying the ID of the authenticated user.
+func NewContext(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the ID of the authenticated user carried by the context. It fails with ErrNoUser if there is
+// none, so data is never assigned to a user by accident.
+func FromContext(ctx context.Context) (uuid.UUID, error) {
+	id, ok := ctx.Value(contextKey{}).(uuid.UUID)
+	if !ok || uuidutils.IsEmpty(id) {
+		return uuid.Nil, ErrNoUser
+	}
+
+	return id, nil
+}
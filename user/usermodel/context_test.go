@@ -0,0 +1,34 @@
+package usermodel_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	if _, err := usermodel.FromContext(context.Background()); !errors.Is(err, usermodel.ErrNoUser) {
+		t.Errorf("expected error '%v' without user in context but got '%v'", usermodel.ErrNoUser, err)
+	}
+
+	_, err := usermodel.FromContext(usermodel.NewContext(context.Background(), uuid.Nil))
+	if !errors.Is(err, usermodel.ErrNoUser) {
+		t.Errorf("expected error '%v' for empty user in context but got '%v'", usermodel.ErrNoUser, err)
+	}
+
+	expected := uuid.New()
+
+	id, err := usermodel.FromContext(usermodel.NewContext(context.Background(), expected))
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if id != expected {
+		t.Errorf("expected user %s but got %s", expected, id)
+	}
+}
@@ -0,0 +1,2 @@
+// Package usermodel provides functionality and business logic to manage the users of the service.
+package usermodel
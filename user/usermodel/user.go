@@ -0,0 +1,120 @@
+package usermodel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rebel-l/go-utils/slice"
+)
+
+const (
+	// MaxLengthName defines the maximum number of characters of the user name.
+	MaxLengthName = 100
+
+	// DefaultName is the name of the user owning all timelogs created before users were introduced.
+	DefaultName = "Default"
+
+	// RoleMember defines the role of a user who can only access their own data.
+	RoleMember = "member"
+
+	// RoleLead defines the role of a user who can read the reports of the members of their team in addition.
+	RoleLead = "lead"
+
+	// RoleAdmin defines the role of a user who can manage the data shared by all users, e.g. public holidays.
+	RoleAdmin = "admin"
+)
+
+// DefaultID is the ID of the user owning all timelogs created before users were introduced.
+var DefaultID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+var (
+	// ErrDecodeJSON occurs if a string is not in JSON format.
+	ErrDecodeJSON = errors.New("failed to decode JSON")
+
+	// ErrValidationNameMandatory occurs during validation if the name wasn't set.
+	ErrValidationNameMandatory = errors.New("name should not be empty")
+
+	// ErrValidationTooLong occurs during validation if a value exceeds its maximum length.
+	ErrValidationTooLong = errors.New("value is too long")
+
+	// ErrValidationInvalidRole occurs during validation if the role is not one of the known ones.
+	ErrValidationInvalidRole = errors.New("role must be one of the following values")
+
+	roles = slice.StringSlice{
+		RoleMember,
+		RoleLead,
+		RoleAdmin,
+	}
+)
+
+// User represents a person tracking their time. Every timelog belongs to exactly one user. The role defines what
+// the user is allowed to do besides tracking their time, an empty role is handled as member.
+type User struct {
+	ID         uuid.UUID  `json:"ID"`
+	Name       string     `json:"Name"`
+	Role       string     `json:"Role"`
+	TeamID     *uuid.UUID `json:"TeamID"`
+	CreatedAt  time.Time  `json:"CreatedAt"`
+	ModifiedAt time.Time  `json:"ModifiedAt"`
+}
+
+// DecodeJSON converts JSON data to struct.
+func (u *User) DecodeJSON(reader io.Reader) error {
+	if u == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(reader)
+	if err := decoder.Decode(u); err != nil {
+		return fmt.Errorf("%w: %v", ErrDecodeJSON, err)
+	}
+
+	return nil
+}
+
+// Validate is validating the attributes of the struct to valid values. If the validation fails it returns the reason
+// why it failed in the error message.
+func (u *User) Validate() error {
+	if u.Name == "" {
+		return ErrValidationNameMandatory
+	}
+
+	if len([]rune(u.Name)) > MaxLengthName {
+		return fmt.Errorf("%w: name has more than %d characters", ErrValidationTooLong, MaxLengthName)
+	}
+
+	if u.Role != "" && roles.IsNotIn(u.Role) {
+		return fmt.Errorf("%w: %s", ErrValidationInvalidRole, roles.String())
+	}
+
+	return nil
+}
+
+// HasRole returns true if the user has one of the given roles.
+func (u *User) HasRole(roles ...string) bool {
+	role := u.Role
+	if role == "" {
+		role = RoleMember
+	}
+
+	for _, v := range roles {
+		if v == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsLeadOf returns true if the user is lead of the team the other user is member of.
+func (u *User) IsLeadOf(other *User) bool {
+	if other == nil || u.TeamID == nil || other.TeamID == nil {
+		return false
+	}
+
+	return u.HasRole(RoleLead) && *u.TeamID == *other.TeamID
+}
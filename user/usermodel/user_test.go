@@ -0,0 +1,114 @@
+package usermodel_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+)
+
+func TestUser_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		user        *usermodel.User
+		expectedErr error
+	}{
+		{
+			name:        "name missing",
+			user:        &usermodel.User{},
+			expectedErr: usermodel.ErrValidationNameMandatory,
+		},
+		{
+			name:        "name too long",
+			user:        &usermodel.User{Name: strings.Repeat("a", usermodel.MaxLengthName+1)},
+			expectedErr: usermodel.ErrValidationTooLong,
+		},
+		{
+			name:        "invalid role",
+			user:        &usermodel.User{Name: "Jane", Role: "boss"},
+			expectedErr: usermodel.ErrValidationInvalidRole,
+		},
+		{
+			name: "valid",
+			user: &usermodel.User{Name: "Jane"},
+		},
+		{
+			name: "valid with role",
+			user: &usermodel.User{Name: "Jane", Role: usermodel.RoleLead},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := testCase.user.Validate(); !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestUser_IsLeadOf(t *testing.T) {
+	t.Parallel()
+
+	team := uuid.New()
+	otherTeam := uuid.New()
+	member := &usermodel.User{Name: "Jane", TeamID: &team}
+
+	testCases := []struct {
+		name     string
+		user     *usermodel.User
+		other    *usermodel.User
+		expected bool
+	}{
+		{
+			name:     "lead of same team",
+			user:     &usermodel.User{Name: "John", Role: usermodel.RoleLead, TeamID: &team},
+			other:    member,
+			expected: true,
+		},
+		{
+			name:  "lead of other team",
+			user:  &usermodel.User{Name: "John", Role: usermodel.RoleLead, TeamID: &otherTeam},
+			other: member,
+		},
+		{
+			name:  "lead without team",
+			user:  &usermodel.User{Name: "John", Role: usermodel.RoleLead},
+			other: &usermodel.User{Name: "Jane"},
+		},
+		{
+			name:  "member of same team",
+			user:  &usermodel.User{Name: "John", TeamID: &team},
+			other: member,
+		},
+		{
+			name:  "admin of same team",
+			user:  &usermodel.User{Name: "John", Role: usermodel.RoleAdmin, TeamID: &team},
+			other: member,
+		},
+		{
+			name: "other user nil",
+			user: &usermodel.User{Name: "John", Role: usermodel.RoleLead, TeamID: &team},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.user.IsLeadOf(testCase.other); got != testCase.expected {
+				t.Errorf("expected lead of to be %t but got %t", testCase.expected, got)
+			}
+		})
+	}
+}
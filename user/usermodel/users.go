@@ -0,0 +1,3 @@
+package usermodel
+
+type Users []*User
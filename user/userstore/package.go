@@ -0,0 +1,2 @@
+// Package userstore contains the CRUD operations for users on the database.
+package userstore
@@ -0,0 +1,176 @@
+package userstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+)
+
+const (
+	qSelect = `
+		SELECT id, name, role, team_id, created_at, modified_at
+        FROM users
+	`
+)
+
+var (
+	// ErrIDMissing will be thrown if an ID is expected but not set.
+	ErrIDMissing = errors.New("id is mandatory for this operation")
+
+	// ErrCreatingID will be thrown if creating an ID failed.
+	ErrCreatingID = errors.New("id creation failed")
+
+	// ErrIDIsSet will be thrown if no ID is expected but already set.
+	ErrIDIsSet = errors.New("id should be not set for this operation, use update instead")
+
+	// ErrDataMissing will be thrown if mandatory data is not set.
+	ErrDataMissing = errors.New("no data or mandatory data missing")
+)
+
+// User represents the user in the database.
+type User struct {
+	ID         uuid.UUID  `db:"id"`
+	Name       string     `db:"name"`
+	Role       string     `db:"role"`
+	TeamID     *uuid.UUID `db:"team_id"`
+	CreatedAt  time.Time  `db:"created_at"`
+	ModifiedAt time.Time  `db:"modified_at"`
+}
+
+// Create creates current object in the database.
+func (u *User) Create(ctx context.Context, db sqlx.ExtContext) error {
+	if !u.IsValid() {
+		return ErrDataMissing
+	}
+
+	if !uuidutils.IsEmpty(u.ID) {
+		return ErrIDIsSet
+	}
+
+	var err error
+
+	u.ID, err = uuid.NewRandom()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCreatingID, err)
+	}
+
+	q := db.Rebind(`
+		INSERT INTO users (id, name, role, team_id)
+		VALUES (?, ?, ?, ?);
+	`)
+
+	_, err = db.ExecContext(ctx, q, u.ID, u.Name, u.Role, u.TeamID)
+	if err != nil {
+		return fmt.Errorf("failed to create: %w", err)
+	}
+
+	return u.Read(ctx, db)
+}
+
+// Read sets the user from database by given ID.
+func (u *User) Read(ctx context.Context, db sqlx.ExtContext) error {
+	if u == nil || uuidutils.IsEmpty(u.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE id = ?;
+    `)
+	if err := sqlx.GetContext(ctx, db, u, q, u.ID); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// ReadByName sets the user from database by given name.
+func (u *User) ReadByName(ctx context.Context, db sqlx.ExtContext) error {
+	if u == nil || u.Name == "" {
+		return ErrDataMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE name = ?;
+    `)
+	if err := sqlx.GetContext(ctx, db, u, q, u.Name); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// Update changes the current object on the database by ID.
+func (u *User) Update(ctx context.Context, db sqlx.ExtContext) error {
+	if !u.IsValid() {
+		return ErrDataMissing
+	}
+
+	if uuidutils.IsEmpty(u.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+		UPDATE users
+		SET name = ?, role = ?, team_id = ?
+		WHERE id = ?;
+	`)
+
+	_, err := db.ExecContext(ctx, q, u.Name, u.Role, u.TeamID, u.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update: %w", err)
+	}
+
+	return u.Read(ctx, db)
+}
+
+// Delete removes the current object from database by its ID.
+func (u *User) Delete(ctx context.Context, db sqlx.ExtContext) error {
+	if u == nil || uuidutils.IsEmpty(u.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+        DELETE FROM users
+        WHERE id = ?
+    `)
+
+	if _, err := db.ExecContext(ctx, q, u.ID); err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	return nil
+}
+
+// HasTimelogs returns true if there are timelogs owned by the user.
+func (u *User) HasTimelogs(ctx context.Context, db sqlx.ExtContext) (bool, error) {
+	if u == nil || uuidutils.IsEmpty(u.ID) {
+		return false, ErrIDMissing
+	}
+
+	var exists bool
+
+	q := db.Rebind(`
+		SELECT EXISTS (SELECT 1 FROM timelogs WHERE user_id = ?);
+	`)
+	if err := sqlx.GetContext(ctx, db, &exists, q, u.ID); err != nil {
+		return false, fmt.Errorf("failed to check timelogs: %w", err)
+	}
+
+	return exists, nil
+}
+
+// IsValid returns true if all mandatory fields are set.
+func (u *User) IsValid() bool {
+	if u == nil || u.Name == "" || u.Role == "" {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,153 @@
+package vacationmapper
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+	"github.com/rebel-l/ttrack_api/vacation/vacationmodel"
+	"github.com/rebel-l/ttrack_api/vacation/vacationstore"
+)
+
+var (
+	// ErrLoadFromDB occurs if something went wrong on loading.
+	ErrLoadFromDB = errors.New("failed to load vacation entitlement from database")
+
+	// ErrNoData occurs if given model is nil.
+	ErrNoData = errors.New("vacation entitlement is nil")
+
+	// ErrSaveToDB occurs if something went wrong on saving.
+	ErrSaveToDB = errors.New("failed to save vacation entitlement to database")
+
+	// ErrNotFound occurs if record doesn't exist in database.
+	ErrNotFound = errors.New("vacation entitlement was not found")
+)
+
+// Mapper provides methods to load and persist vacation entitlement models.
+type Mapper struct {
+	db *sqlx.DB
+}
+
+// New returns a new mapper.
+func New(db *sqlx.DB) *Mapper {
+	return &Mapper{db: db}
+}
+
+// LoadByYear returns the entitlement of the user of the context for the given year.
+func (m *Mapper) LoadByYear(ctx context.Context, year int) (*vacationmodel.Entitlement, error) {
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoadFromDB, err)
+	}
+
+	s := &vacationstore.Entitlement{UserID: userID, Year: year} // nolint: exhaustivestruct
+
+	if err := s.ReadByYear(ctx, m.db); errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// Save persists (create or update) the model and returns the changed data (id, createdAt or modifiedAt). The
+// entitlement belongs to the user of the context, entitlements of other users are not found. There is only one
+// entitlement per user and year, so a model without ID replaces an existing entitlement of the same year.
+func (m *Mapper) Save(ctx context.Context, model *vacationmodel.Entitlement) (*vacationmodel.Entitlement, error) {
+	if model == nil {
+		return nil, ErrNoData
+	}
+
+	userID, err := usermodel.FromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSaveToDB, err)
+	}
+
+	model.UserID = userID
+	s := modelToStore(model)
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	if uuidutils.IsEmpty(s.ID) {
+		existing := &vacationstore.Entitlement{UserID: userID, Year: s.Year} // nolint: exhaustivestruct
+		if err := existing.ReadByYear(ctx, tx); err == nil {
+			s.ID = existing.ID
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	} else {
+		stored := &vacationstore.Entitlement{ID: s.ID} // nolint: exhaustivestruct
+		if err := stored.Read(ctx, tx); errors.Is(err, sql.ErrNoRows) || err == nil && stored.UserID != userID {
+			return nil, fmt.Errorf("%w: %w", ErrSaveToDB, ErrNotFound)
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrLoadFromDB, err)
+		}
+	}
+
+	if uuidutils.IsEmpty(s.ID) {
+		if err := s.Create(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	} else {
+		if err := s.Update(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSaveToDB, err)
+	}
+
+	return StoreToModel(s), nil
+}
+
+// StoreToModel returns a model based on the given store object. It maps all properties from store to model.
+func StoreToModel(s *vacationstore.Entitlement) *vacationmodel.Entitlement {
+	if s == nil {
+		return &vacationmodel.Entitlement{} // nolint: exhaustivestruct
+	}
+
+	return &vacationmodel.Entitlement{
+		ID:               s.ID,
+		UserID:           s.UserID,
+		Year:             s.Year,
+		Days:             s.Days,
+		CarryOver:        s.CarryOver,
+		CarryOverExpires: s.CarryOverExpires,
+		CreatedAt:        s.CreatedAt,
+		ModifiedAt:       s.ModifiedAt,
+	}
+}
+
+// modelToStore returns a store based on the given model object. It maps all properties from model to store. The
+// expiry date is stored as date in UTC.
+func modelToStore(m *vacationmodel.Entitlement) *vacationstore.Entitlement {
+	var expires *time.Time
+
+	if m.CarryOverExpires != nil {
+		e := time.Date(m.CarryOverExpires.Year(), m.CarryOverExpires.Month(), m.CarryOverExpires.Day(), 0, 0, 0, 0, time.UTC)
+		expires = &e
+	}
+
+	return &vacationstore.Entitlement{
+		ID:               m.ID,
+		UserID:           m.UserID,
+		Year:             m.Year,
+		Days:             m.Days,
+		CarryOver:        m.CarryOver,
+		CarryOverExpires: expires,
+		CreatedAt:        m.CreatedAt,
+		ModifiedAt:       m.ModifiedAt,
+	}
+}
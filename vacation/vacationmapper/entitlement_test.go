@@ -0,0 +1,116 @@
+package vacationmapper_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rebel-l/go-utils/osutils"
+	"github.com/rebel-l/ttrack_api/bootstrap"
+	"github.com/rebel-l/ttrack_api/config"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+	"github.com/rebel-l/ttrack_api/vacation/vacationmapper"
+	"github.com/rebel-l/ttrack_api/vacation/vacationmodel"
+)
+
+func setup(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+
+	// 0. init path
+	storagePath := filepath.Join(".", "..", "..", "storage", "test_vacation", name)
+	scriptPath := filepath.Join(".", "..", "..", "scripts", "sql", "sqlite")
+	conf := &config.Database{
+		StoragePath:       &storagePath,
+		SchemaScriptsPath: &scriptPath,
+	}
+
+	// 1. clean up
+	if osutils.FileOrPathExists(conf.GetStoragePath()) {
+		if err := os.RemoveAll(conf.GetStoragePath()); err != nil {
+			t.Fatalf("failed to cleanup test files: %v", err)
+		}
+	}
+
+	// 2. init database
+	db, err := bootstrap.Database(conf, "0.0.0", false)
+	if err != nil {
+		t.Fatalf("No error expected: %v", err)
+	}
+
+	return db
+}
+
+func TestMapper_SaveLoad(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperSaveLoad")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	mapper := vacationmapper.New(db)
+	ctx := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+
+	// 2. test
+	if _, err := mapper.Save(ctx, nil); !errors.Is(err, vacationmapper.ErrNoData) {
+		t.Errorf("expected error '%v' but got '%v'", vacationmapper.ErrNoData, err)
+	}
+
+	if _, err := mapper.LoadByYear(ctx, 2024); !errors.Is(err, vacationmapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", vacationmapper.ErrNotFound, err)
+	}
+
+	expires := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	saved, err := mapper.Save(ctx, &vacationmodel.Entitlement{Year: 2024, Days: 30, CarryOver: 2.5, CarryOverExpires: &expires})
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	// without ID the entitlement of the same year is replaced
+	replaced, err := mapper.Save(ctx, &vacationmodel.Entitlement{Year: 2024, Days: 28})
+	if err != nil {
+		t.Fatalf("expected no error on replace but got '%v'", err)
+	}
+
+	if replaced.ID != saved.ID {
+		t.Errorf("expected entitlement %s to be replaced but got new one %s", saved.ID, replaced.ID)
+	}
+
+	loaded, err := mapper.LoadByYear(ctx, 2024)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if loaded.ID != saved.ID || loaded.Days != 28 || loaded.CarryOver != 0 || loaded.CarryOverExpires != nil {
+		t.Errorf("expected entitlement '%+v' but got '%+v'", replaced, loaded)
+	}
+
+	saved.Days = 25
+
+	if _, err := mapper.Save(ctx, saved); err != nil {
+		t.Fatalf("expected no error on update but got '%v'", err)
+	}
+
+	loaded, err = mapper.LoadByYear(ctx, 2024)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if loaded.Days != 25 || loaded.CarryOver != 2.5 || loaded.CarryOverExpires == nil || !loaded.CarryOverExpires.Equal(expires) {
+		t.Errorf("expected entitlement '%+v' but got '%+v'", saved, loaded)
+	}
+}
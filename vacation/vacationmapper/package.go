@@ -0,0 +1,2 @@
+// Package vacationmapper provides functionality to read and persist vacation entitlements.
+package vacationmapper
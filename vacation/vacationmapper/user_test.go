@@ -0,0 +1,67 @@
+package vacationmapper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rebel-l/ttrack_api/user/usermapper"
+	"github.com/rebel-l/ttrack_api/user/usermodel"
+	"github.com/rebel-l/ttrack_api/vacation/vacationmapper"
+	"github.com/rebel-l/ttrack_api/vacation/vacationmodel"
+)
+
+func TestMapper_User(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("long running test")
+	}
+
+	// 1. setup
+	db := setup(t, "mapperUser")
+
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("unable to close database connection: %v", err)
+		}
+	})
+
+	user, err := usermapper.New(db).Save(context.Background(), &usermodel.User{Name: "Jane"})
+	if err != nil {
+		t.Fatalf("failed to prepare user: %v", err)
+	}
+
+	mapper := vacationmapper.New(db)
+	ctxDefault := usermodel.NewContext(context.Background(), usermodel.DefaultID)
+	ctxUser := usermodel.NewContext(context.Background(), user.ID)
+
+	own, err := mapper.Save(ctxDefault, &vacationmodel.Entitlement{Year: 2024, Days: 30})
+	if err != nil {
+		t.Fatalf("failed to prepare entitlement of default user: %v", err)
+	}
+
+	// 2. test
+	// the same year doesn't replace the entitlement of other users
+	other, err := mapper.Save(ctxUser, &vacationmodel.Entitlement{Year: 2024, Days: 25})
+	if err != nil {
+		t.Fatalf("expected no error on save but got '%v'", err)
+	}
+
+	if own.ID == other.ID || own.UserID != usermodel.DefaultID || other.UserID != user.ID {
+		t.Errorf("expected separate entitlements per user but got '%+v' and '%+v'", own, other)
+	}
+
+	if _, err := mapper.Save(ctxDefault, other); !errors.Is(err, vacationmapper.ErrNotFound) {
+		t.Errorf("expected error '%v' but got '%v'", vacationmapper.ErrNotFound, err)
+	}
+
+	loaded, err := mapper.LoadByYear(ctxDefault, 2024)
+	if err != nil {
+		t.Fatalf("expected no error on load but got '%v'", err)
+	}
+
+	if loaded.ID != own.ID || loaded.Days != 30 {
+		t.Errorf("expected entitlement '%+v' but got '%+v'", own, loaded)
+	}
+}
@@ -0,0 +1,75 @@
+package vacationmodel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxDays defines the maximum number of vacation days per year, entitlement and carry-over each.
+const MaxDays = 366
+
+var (
+	// ErrDecodeJSON occurs if a string is not in JSON format.
+	ErrDecodeJSON = errors.New("failed to decode JSON")
+
+	// ErrValidationYearMandatory occurs during validation if the year wasn't set.
+	ErrValidationYearMandatory = errors.New("year should not be empty")
+
+	// ErrValidationDays occurs during validation if the days or carried days are out of range.
+	ErrValidationDays = errors.New("days are out of range")
+)
+
+// Entitlement represents the vacation days granted for a year. Days carried over from the previous year expire at
+// CarryOverExpires if they are not taken until then. Without expiry date they are valid for the whole year.
+type Entitlement struct {
+	ID               uuid.UUID  `json:"ID"`
+	UserID           uuid.UUID  `json:"UserID"`
+	Year             int        `json:"Year"`
+	Days             float64    `json:"Days"`
+	CarryOver        float64    `json:"CarryOver"`
+	CarryOverExpires *time.Time `json:"CarryOverExpires,omitempty"`
+	CreatedAt        time.Time  `json:"CreatedAt"`
+	ModifiedAt       time.Time  `json:"ModifiedAt"`
+}
+
+// DecodeJSON converts JSON data to struct.
+func (e *Entitlement) DecodeJSON(reader io.Reader) error {
+	if e == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(reader)
+	if err := decoder.Decode(e); err != nil {
+		return fmt.Errorf("%w: %v", ErrDecodeJSON, err)
+	}
+
+	return nil
+}
+
+// Validate is validating the attributes of the struct to valid values. If the validation fails it returns the reason
+// why it failed in the error message.
+func (e *Entitlement) Validate() error {
+	if e.Year <= 0 {
+		return ErrValidationYearMandatory
+	}
+
+	if e.Days < 0 || e.Days > MaxDays {
+		return fmt.Errorf("%w: days has %v", ErrValidationDays, e.Days)
+	}
+
+	if e.CarryOver < 0 || e.CarryOver > MaxDays {
+		return fmt.Errorf("%w: carry-over has %v", ErrValidationDays, e.CarryOver)
+	}
+
+	return nil
+}
+
+// Total returns the sum of the days granted for the year and the carried days.
+func (e *Entitlement) Total() float64 {
+	return e.Days + e.CarryOver
+}
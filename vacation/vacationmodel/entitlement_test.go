@@ -0,0 +1,48 @@
+package vacationmodel_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rebel-l/ttrack_api/vacation/vacationmodel"
+)
+
+func TestEntitlement_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		entitlement *vacationmodel.Entitlement
+		expectedErr error
+	}{
+		{
+			name:        "year missing",
+			entitlement: &vacationmodel.Entitlement{Days: 30},
+			expectedErr: vacationmodel.ErrValidationYearMandatory,
+		},
+		{
+			name:        "negative days",
+			entitlement: &vacationmodel.Entitlement{Year: 2024, Days: -1},
+			expectedErr: vacationmodel.ErrValidationDays,
+		},
+		{
+			name:        "too many carried days",
+			entitlement: &vacationmodel.Entitlement{Year: 2024, Days: 30, CarryOver: 400},
+			expectedErr: vacationmodel.ErrValidationDays,
+		},
+		{
+			name:        "valid",
+			entitlement: &vacationmodel.Entitlement{Year: 2024, Days: 30, CarryOver: 2.5},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := testCase.entitlement.Validate(); !errors.Is(err, testCase.expectedErr) {
+				t.Errorf("expected error '%v' but got '%v'", testCase.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,2 @@
+// Package vacationmodel provides functionality and business logic to manage vacation entitlements.
+package vacationmodel
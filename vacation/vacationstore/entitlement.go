@@ -0,0 +1,160 @@
+package vacationstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rebel-l/go-utils/uuidutils"
+)
+
+const (
+	qSelect = `
+		SELECT id, user_id, year, days, carry_over, carry_over_expires, created_at, modified_at
+        FROM vacations
+	`
+)
+
+var (
+	// ErrIDMissing will be thrown if an ID is expected but not set.
+	ErrIDMissing = errors.New("id is mandatory for this operation")
+
+	// ErrCreatingID will be thrown if creating an ID failed.
+	ErrCreatingID = errors.New("id creation failed")
+
+	// ErrIDIsSet will be thrown if no ID is expected but already set.
+	ErrIDIsSet = errors.New("id should be not set for this operation, use update instead")
+
+	// ErrDataMissing will be thrown if mandatory data is not set.
+	ErrDataMissing = errors.New("no data or mandatory data missing")
+)
+
+// Entitlement represents the vacation entitlement in the database.
+type Entitlement struct {
+	ID               uuid.UUID  `db:"id"`
+	UserID           uuid.UUID  `db:"user_id"`
+	Year             int        `db:"year"`
+	Days             float64    `db:"days"`
+	CarryOver        float64    `db:"carry_over"`
+	CarryOverExpires *time.Time `db:"carry_over_expires"`
+	CreatedAt        time.Time  `db:"created_at"`
+	ModifiedAt       time.Time  `db:"modified_at"`
+}
+
+// Create creates current object in the database.
+func (e *Entitlement) Create(ctx context.Context, db sqlx.ExtContext) error {
+	if !e.IsValid() {
+		return ErrDataMissing
+	}
+
+	if !uuidutils.IsEmpty(e.ID) {
+		return ErrIDIsSet
+	}
+
+	var err error
+
+	e.ID, err = uuid.NewRandom()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCreatingID, err)
+	}
+
+	q := db.Rebind(`
+		INSERT INTO vacations (id, user_id, year, days, carry_over, carry_over_expires) 
+		VALUES (?, ?, ?, ?, ?, ?);
+	`)
+
+	_, err = db.ExecContext(ctx, q, e.ID, e.UserID, e.Year, e.Days, e.CarryOver, e.CarryOverExpires)
+	if err != nil {
+		return fmt.Errorf("failed to create: %w", err)
+	}
+
+	return e.Read(ctx, db)
+}
+
+// Read sets the entitlement from database by given ID.
+func (e *Entitlement) Read(ctx context.Context, db sqlx.ExtContext) error {
+	if e == nil || uuidutils.IsEmpty(e.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE id = ?;
+    `)
+	if err := sqlx.GetContext(ctx, db, e, q, e.ID); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// ReadByYear sets the entitlement from database by given user and year.
+func (e *Entitlement) ReadByYear(ctx context.Context, db sqlx.ExtContext) error {
+	if e == nil || uuidutils.IsEmpty(e.UserID) || e.Year <= 0 {
+		return ErrDataMissing
+	}
+
+	q := db.Rebind(
+		qSelect + `
+        WHERE user_id = ? AND year = ?;
+    `)
+	if err := sqlx.GetContext(ctx, db, e, q, e.UserID, e.Year); err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// Update changes the current object on the database by ID.
+func (e *Entitlement) Update(ctx context.Context, db sqlx.ExtContext) error {
+	if !e.IsValid() {
+		return ErrDataMissing
+	}
+
+	if uuidutils.IsEmpty(e.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+		UPDATE vacations 
+		SET year = ?, days = ?, carry_over = ?, carry_over_expires = ? 
+		WHERE id = ?;
+	`)
+
+	_, err := db.ExecContext(ctx, q, e.Year, e.Days, e.CarryOver, e.CarryOverExpires, e.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update: %w", err)
+	}
+
+	return e.Read(ctx, db)
+}
+
+// Delete removes the current object from database by its ID.
+func (e *Entitlement) Delete(ctx context.Context, db sqlx.ExtContext) error {
+	if e == nil || uuidutils.IsEmpty(e.ID) {
+		return ErrIDMissing
+	}
+
+	q := db.Rebind(`
+        DELETE FROM vacations
+        WHERE id = ?
+    `)
+
+	if _, err := db.ExecContext(ctx, q, e.ID); err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+
+	return nil
+}
+
+// IsValid returns true if all mandatory fields are set.
+func (e *Entitlement) IsValid() bool {
+	if e == nil || uuidutils.IsEmpty(e.UserID) || e.Year <= 0 {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,2 @@
+// Package vacationstore contains the CRUD operations for the vacation entitlements on the database.
+package vacationstore